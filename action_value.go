@@ -0,0 +1,96 @@
+package slack
+
+import (
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/json"
+	"strings"
+)
+
+// Size limits slack enforces on values set by apps.
+const (
+	MaxButtonValueLength     = 2000
+	MaxOptionValueLength     = 150
+	MaxPrivateMetadataLength = 3000
+)
+
+// ValueCodec encodes small typed payloads into the string values carried by interactions,
+// such as button and option values or a view's private_metadata.
+// When created with a key the values are signed, so payloads altered by the client are rejected.
+type ValueCodec struct {
+	key []byte
+}
+
+// NewValueCodec creates a codec, values are signed with HMAC-SHA256 when key isn't empty.
+func NewValueCodec(key []byte) ValueCodec {
+	return ValueCodec{key: key}
+}
+
+// Encode marshals v to JSON and encodes it into a string no longer than limit.
+// A limit of zero or less disables the size check.
+func (c ValueCodec) Encode(v interface{}, limit int) (string, error) {
+	payload, err := json.Marshal(v)
+	if err != nil {
+		return "", err
+	}
+
+	value := base64.RawURLEncoding.EncodeToString(payload)
+	if len(c.key) > 0 {
+		value += "." + base64.RawURLEncoding.EncodeToString(c.sign(payload))
+	}
+
+	if limit > 0 && len(value) > limit {
+		return "", ErrValueTooLarge
+	}
+
+	return value, nil
+}
+
+// EncodeButtonValue encodes v within the limit of a button value.
+func (c ValueCodec) EncodeButtonValue(v interface{}) (string, error) {
+	return c.Encode(v, MaxButtonValueLength)
+}
+
+// EncodeOptionValue encodes v within the limit of an option value.
+func (c ValueCodec) EncodeOptionValue(v interface{}) (string, error) {
+	return c.Encode(v, MaxOptionValueLength)
+}
+
+// EncodePrivateMetadata encodes v within the limit of a view's private_metadata.
+func (c ValueCodec) EncodePrivateMetadata(v interface{}) (string, error) {
+	return c.Encode(v, MaxPrivateMetadataLength)
+}
+
+// Decode verifies the value and unmarshals its payload into v.
+// Values without a valid signature are rejected when the codec has a key.
+func (c ValueCodec) Decode(value string, v interface{}) error {
+	encoded, signature := value, ""
+	if i := strings.IndexByte(value, '.'); i >= 0 {
+		encoded, signature = value[:i], value[i+1:]
+	}
+
+	payload, err := base64.RawURLEncoding.DecodeString(encoded)
+	if err != nil {
+		return ErrValueMalformed
+	}
+
+	if len(c.key) > 0 {
+		mac, err := base64.RawURLEncoding.DecodeString(signature)
+		if err != nil || !hmac.Equal(mac, c.sign(payload)) {
+			return ErrValueSignature
+		}
+	}
+
+	if err := json.Unmarshal(payload, v); err != nil {
+		return ErrValueMalformed
+	}
+
+	return nil
+}
+
+func (c ValueCodec) sign(payload []byte) []byte {
+	mac := hmac.New(sha256.New, c.key)
+	mac.Write(payload)
+	return mac.Sum(nil)
+}
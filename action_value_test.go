@@ -0,0 +1,70 @@
+package slack
+
+import (
+	"strings"
+	"testing"
+)
+
+type testActionValue struct {
+	ID    int    `json:"id"`
+	Scope string `json:"scope"`
+}
+
+func TestValueCodec(t *testing.T) {
+	want := testActionValue{ID: 42, Scope: "team"}
+
+	for name, codec := range map[string]ValueCodec{
+		"unsigned": NewValueCodec(nil),
+		"signed":   NewValueCodec([]byte("secret")),
+	} {
+		t.Run(name, func(t *testing.T) {
+			value, err := codec.EncodeOptionValue(want)
+			if err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+
+			var got testActionValue
+			if err := codec.Decode(value, &got); err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+			if got != want {
+				t.Errorf("expected %+v, got %+v", want, got)
+			}
+		})
+	}
+}
+
+func TestValueCodecRejectsForgedValues(t *testing.T) {
+	codec := NewValueCodec([]byte("secret"))
+
+	forged, err := NewValueCodec([]byte("other")).Encode(testActionValue{ID: 1}, 0)
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	unsigned, err := NewValueCodec(nil).Encode(testActionValue{ID: 1}, 0)
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	var v testActionValue
+	for _, value := range []string{forged, unsigned} {
+		if err := codec.Decode(value, &v); err != ErrValueSignature {
+			t.Errorf("%q: expected %v, got %v", value, ErrValueSignature, err)
+		}
+	}
+
+	if err := codec.Decode("!!!", &v); err != ErrValueMalformed {
+		t.Errorf("expected %v, got %v", ErrValueMalformed, err)
+	}
+}
+
+func TestValueCodecSizeLimit(t *testing.T) {
+	codec := NewValueCodec([]byte("secret"))
+
+	if _, err := codec.EncodeOptionValue(strings.Repeat("x", MaxOptionValueLength)); err != ErrValueTooLarge {
+		t.Errorf("expected %v, got %v", ErrValueTooLarge, err)
+	}
+	if _, err := codec.EncodeButtonValue(strings.Repeat("x", MaxOptionValueLength)); err != nil {
+		t.Errorf("unexpected error: %s", err)
+	}
+}
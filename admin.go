@@ -9,7 +9,11 @@ import (
 
 func (api *Client) adminRequest(ctx context.Context, method string, teamName string, values url.Values) error {
 	resp := &SlackResponse{}
-	err := parseAdminResponse(ctx, api.httpclient, method, teamName, values, resp, api)
+	if err := api.authenticate(ctx, values); err != nil {
+		return err
+	}
+	mergeParams(values, contextParams(ctx))
+	err := parseAdminResponse(ctx, api.httpclient, api.adminURLFormat, method, teamName, values, resp, api)
 	if err != nil {
 		return err
 	}
@@ -0,0 +1,246 @@
+package slack
+
+import (
+	"compress/gzip"
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/url"
+	"strings"
+)
+
+// AnalyticsType is the kind of analytics requested with admin.analytics.getFile.
+type AnalyticsType string
+
+const (
+	AnalyticsTypeMember        AnalyticsType = "member"
+	AnalyticsTypePublicChannel AnalyticsType = "public_channel"
+)
+
+// GetAnalyticsFileParameters contains arguments for GetAnalyticsFile method call
+type GetAnalyticsFileParameters struct {
+	Type AnalyticsType
+	// Date is the day of the analytics, as YYYY-MM-DD.
+	Date string
+	// MetadataOnly requests the metadata of the public channels, e.g. their names, instead of
+	// their analytics. Date must be empty.
+	MetadataOnly bool
+}
+
+// MemberAnalytics is the activity of a member of an Enterprise Grid organization during a day.
+type MemberAnalytics struct {
+	EnterpriseID               string `json:"enterprise_id"`
+	TeamID                     string `json:"team_id"`
+	Date                       string `json:"date"`
+	UserID                     string `json:"user_id"`
+	EmailAddress               string `json:"email_address"`
+	IsGuest                    bool   `json:"is_guest"`
+	IsBillableSeat             bool   `json:"is_billable_seat"`
+	IsActive                   bool   `json:"is_active"`
+	IsActiveIOS                bool   `json:"is_active_ios"`
+	IsActiveAndroid            bool   `json:"is_active_android"`
+	IsActiveDesktop            bool   `json:"is_active_desktop"`
+	IsActiveApps               bool   `json:"is_active_apps"`
+	IsActiveWorkflows          bool   `json:"is_active_workflows"`
+	IsActiveSlackConnect       bool   `json:"is_active_slack_connect"`
+	ReactionsAddedCount        int    `json:"reactions_added_count"`
+	MessagesPostedCount        int    `json:"messages_posted_count"`
+	ChannelMessagesPostedCount int    `json:"channel_messages_posted_count"`
+	FilesAddedCount            int    `json:"files_added_count"`
+	TotalCallsCount            int    `json:"total_calls_count"`
+	SlackCallsCount            int    `json:"slack_calls_count"`
+	SlackHuddlesCount          int    `json:"slack_huddles_count"`
+	SearchCount                int    `json:"search_count"`
+	DateClaimed                int64  `json:"date_claimed"`
+}
+
+// PublicChannelAnalytics is the activity of a public channel of an Enterprise Grid organization during a day.
+type PublicChannelAnalytics struct {
+	EnterpriseID    string `json:"enterprise_id"`
+	TeamID          string `json:"team_id"`
+	OriginatingTeam struct {
+		TeamID string `json:"team_id"`
+		Name   string `json:"name"`
+	} `json:"originating_team"`
+	ChannelID                         string   `json:"channel_id"`
+	Date                              string   `json:"date"`
+	DateCreated                       int64    `json:"date_created"`
+	DateLastActive                    int64    `json:"date_last_active"`
+	TotalMembersCount                 int      `json:"total_members_count"`
+	FullMembersCount                  int      `json:"full_members_count"`
+	GuestMemberCount                  int      `json:"guest_member_count"`
+	MessagesPostedCount               int      `json:"messages_posted_count"`
+	MessagesPostedByMembersCount      int      `json:"messages_posted_by_members_count"`
+	MembersWhoViewedCount             int      `json:"members_who_viewed_count"`
+	MembersWhoPostedCount             int      `json:"members_who_posted_count"`
+	ReactionsAddedCount               int      `json:"reactions_added_count"`
+	Visibility                        string   `json:"visibility"`
+	ChannelType                       string   `json:"channel_type"`
+	IsSharedExternally                bool     `json:"is_shared_externally"`
+	SharedWith                        []string `json:"shared_with"`
+	ExternallySharedWithOrganizations []struct {
+		Name   string `json:"name"`
+		Domain string `json:"domain"`
+	} `json:"externally_shared_with_organizations"`
+}
+
+// PublicChannelMetadata describes a public channel, it's requested with MetadataOnly.
+type PublicChannelMetadata struct {
+	ChannelID   string `json:"channel_id"`
+	Date        string `json:"date"`
+	Name        string `json:"name"`
+	Topic       string `json:"topic"`
+	Description string `json:"description"`
+}
+
+// AnalyticsReader decodes the records of a gzipped JSON Lines analytics file.
+type AnalyticsReader struct {
+	gz  *gzip.Reader
+	dec *json.Decoder
+}
+
+// NewAnalyticsReader returns a reader decoding the analytics file read from r.
+func NewAnalyticsReader(r io.Reader) (*AnalyticsReader, error) {
+	gz, err := gzip.NewReader(r)
+	if err != nil {
+		return nil, err
+	}
+
+	return &AnalyticsReader{gz: gz, dec: json.NewDecoder(gz)}, nil
+}
+
+// Next decodes the next record into record, e.g. a *MemberAnalytics. io.EOF is returned
+// once every record was read.
+func (r *AnalyticsReader) Next(record interface{}) error {
+	return r.dec.Decode(record)
+}
+
+// Close releases the resources of the reader, it doesn't close the underlying reader.
+func (r *AnalyticsReader) Close() error {
+	return r.gz.Close()
+}
+
+// GetAnalyticsFile downloads the gzipped JSON Lines analytics file of an Enterprise Grid
+// organization to writer. The file can be decoded with NewAnalyticsReader.
+func (api *Client) GetAnalyticsFile(params GetAnalyticsFileParameters, writer io.Writer) error {
+	return api.GetAnalyticsFileContext(context.Background(), params, writer)
+}
+
+// GetAnalyticsFileContext downloads an analytics file with a custom context.
+func (api *Client) GetAnalyticsFileContext(ctx context.Context, params GetAnalyticsFileParameters, writer io.Writer) error {
+	return api.readAnalyticsFile(ctx, params, func(r io.Reader) error {
+		_, err := io.Copy(writer, r)
+		return err
+	})
+}
+
+// GetMemberAnalytics streams the member analytics of the day, as YYYY-MM-DD, to handle.
+// The download stops at the first error returned by handle.
+func (api *Client) GetMemberAnalytics(date string, handle func(MemberAnalytics) error) error {
+	return api.GetMemberAnalyticsContext(context.Background(), date, handle)
+}
+
+// GetMemberAnalyticsContext streams the member analytics of the day with a custom context.
+func (api *Client) GetMemberAnalyticsContext(ctx context.Context, date string, handle func(MemberAnalytics) error) error {
+	params := GetAnalyticsFileParameters{Type: AnalyticsTypeMember, Date: date}
+	return api.readAnalyticsRecords(ctx, params, func(r *AnalyticsReader) error {
+		var record MemberAnalytics
+		if err := r.Next(&record); err != nil {
+			return err
+		}
+		return handle(record)
+	})
+}
+
+// GetPublicChannelAnalytics streams the public channel analytics of the day, as YYYY-MM-DD,
+// to handle. The download stops at the first error returned by handle.
+func (api *Client) GetPublicChannelAnalytics(date string, handle func(PublicChannelAnalytics) error) error {
+	return api.GetPublicChannelAnalyticsContext(context.Background(), date, handle)
+}
+
+// GetPublicChannelAnalyticsContext streams the public channel analytics of the day with a custom context.
+func (api *Client) GetPublicChannelAnalyticsContext(ctx context.Context, date string, handle func(PublicChannelAnalytics) error) error {
+	params := GetAnalyticsFileParameters{Type: AnalyticsTypePublicChannel, Date: date}
+	return api.readAnalyticsRecords(ctx, params, func(r *AnalyticsReader) error {
+		var record PublicChannelAnalytics
+		if err := r.Next(&record); err != nil {
+			return err
+		}
+		return handle(record)
+	})
+}
+
+// GetPublicChannelMetadata streams the metadata of the public channels to handle.
+// The download stops at the first error returned by handle.
+func (api *Client) GetPublicChannelMetadata(handle func(PublicChannelMetadata) error) error {
+	return api.GetPublicChannelMetadataContext(context.Background(), handle)
+}
+
+// GetPublicChannelMetadataContext streams the metadata of the public channels with a custom context.
+func (api *Client) GetPublicChannelMetadataContext(ctx context.Context, handle func(PublicChannelMetadata) error) error {
+	params := GetAnalyticsFileParameters{Type: AnalyticsTypePublicChannel, MetadataOnly: true}
+	return api.readAnalyticsRecords(ctx, params, func(r *AnalyticsReader) error {
+		var record PublicChannelMetadata
+		if err := r.Next(&record); err != nil {
+			return err
+		}
+		return handle(record)
+	})
+}
+
+// readAnalyticsRecords calls next until it returns an error, io.EOF ends the file successfully.
+func (api *Client) readAnalyticsRecords(ctx context.Context, params GetAnalyticsFileParameters, next func(r *AnalyticsReader) error) error {
+	return api.readAnalyticsFile(ctx, params, func(body io.Reader) error {
+		r, err := NewAnalyticsReader(body)
+		if err != nil {
+			return err
+		}
+		defer r.Close()
+
+		for {
+			if err := next(r); err == io.EOF {
+				return nil
+			} else if err != nil {
+				return err
+			}
+		}
+	})
+}
+
+// readAnalyticsFile requests the analytics file and passes its body to read. Slack responds
+// with JSON instead of the file when the request fails.
+func (api *Client) readAnalyticsFile(ctx context.Context, params GetAnalyticsFileParameters, read func(r io.Reader) error) error {
+	values := url.Values{
+		"token": {api.token},
+		"type":  {string(params.Type)},
+	}
+	if params.Date != "" {
+		values.Add("date", params.Date)
+	}
+	if params.MetadataOnly {
+		values.Add("metadata_only", "true")
+	}
+
+	if err := api.authenticate(ctx, values); err != nil {
+		return err
+	}
+	mergeParams(values, contextParams(ctx))
+
+	req, err := formReq(api.endpoint+"admin.analytics.getFile", values)
+	if err != nil {
+		return err
+	}
+
+	return doPost(ctx, api.httpclient, req, func(resp *http.Response) error {
+		if strings.HasPrefix(resp.Header.Get("Content-Type"), "application/json") {
+			response := &SlackResponse{}
+			if err := json.NewDecoder(resp.Body).Decode(response); err != nil {
+				return err
+			}
+			return response.Err()
+		}
+
+		return read(resp.Body)
+	}, api)
+}
@@ -0,0 +1,97 @@
+package slack
+
+import (
+	"bytes"
+	"compress/gzip"
+	"errors"
+	"net/http"
+	"testing"
+)
+
+func gzipLines(t *testing.T, lines ...string) []byte {
+	var buf bytes.Buffer
+	gz := gzip.NewWriter(&buf)
+	for _, line := range lines {
+		if _, err := gz.Write([]byte(line + "\n")); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if err := gz.Close(); err != nil {
+		t.Fatal(err)
+	}
+	return buf.Bytes()
+}
+
+func TestGetAnalyticsFile(t *testing.T) {
+	http.DefaultServeMux = new(http.ServeMux)
+	defer func() { http.DefaultServeMux = new(http.ServeMux) }()
+
+	members := gzipLines(t,
+		`{"enterprise_id": "E1", "date": "2020-09-13", "user_id": "W1", "email_address": "a@example.com", "is_active": true, "messages_posted_count": 12}`,
+		`{"enterprise_id": "E1", "date": "2020-09-13", "user_id": "W2", "is_guest": true}`,
+	)
+	metadata := gzipLines(t, `{"channel_id": "C1", "date": "2020-09-13", "name": "general", "topic": "news"}`)
+
+	http.HandleFunc("/admin.analytics.getFile", func(rw http.ResponseWriter, r *http.Request) {
+		switch {
+		case r.FormValue("type") == "member" && r.FormValue("date") == "2020-09-13":
+			rw.Header().Set("Content-Type", "application/gzip")
+			rw.Write(members)
+		case r.FormValue("type") == "public_channel" && r.FormValue("metadata_only") == "true" && r.FormValue("date") == "":
+			rw.Header().Set("Content-Type", "application/gzip")
+			rw.Write(metadata)
+		default:
+			rw.Header().Set("Content-Type", "application/json; charset=utf-8")
+			rw.Write([]byte(`{"ok": false, "error": "file_not_yet_available"}`))
+		}
+	})
+	once.Do(startServer)
+	api := New(validToken, OptionAPIURL("http://"+serverAddr+"/"))
+
+	var got []MemberAnalytics
+	err := api.GetMemberAnalytics("2020-09-13", func(record MemberAnalytics) error {
+		got = append(got, record)
+		return nil
+	})
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	if len(got) != 2 || got[0].UserID != "W1" || !got[0].IsActive || got[0].MessagesPostedCount != 12 || !got[1].IsGuest {
+		t.Errorf("unexpected records %#v", got)
+	}
+
+	stop := errors.New("stop")
+	calls := 0
+	err = api.GetMemberAnalytics("2020-09-13", func(record MemberAnalytics) error {
+		calls++
+		return stop
+	})
+	if err != stop || calls != 1 {
+		t.Errorf("expected the handler error after 1 call, got %v after %d", err, calls)
+	}
+
+	var channels []PublicChannelMetadata
+	err = api.GetPublicChannelMetadata(func(record PublicChannelMetadata) error {
+		channels = append(channels, record)
+		return nil
+	})
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	if len(channels) != 1 || channels[0].Name != "general" || channels[0].Topic != "news" {
+		t.Errorf("unexpected records %#v", channels)
+	}
+
+	var file bytes.Buffer
+	if err := api.GetAnalyticsFile(GetAnalyticsFileParameters{Type: AnalyticsTypeMember, Date: "2020-09-13"}, &file); err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	if !bytes.Equal(file.Bytes(), members) {
+		t.Error("expected the file to be downloaded as is")
+	}
+
+	err = api.GetPublicChannelAnalytics("2020-09-14", func(PublicChannelAnalytics) error { return nil })
+	if err == nil || err.Error() != "file_not_yet_available" {
+		t.Errorf("expected file_not_yet_available, got %v", err)
+	}
+}
@@ -0,0 +1,117 @@
+package slack
+
+import (
+	"context"
+	"net/url"
+	"strconv"
+)
+
+// AdminEmoji is a custom emoji of an Enterprise Grid organization.
+type AdminEmoji struct {
+	URL         string   `json:"url"`
+	DateCreated JSONTime `json:"date_created"`
+	UploadedBy  string   `json:"uploaded_by"`
+}
+
+type adminEmojiListResponse struct {
+	Emoji            map[string]AdminEmoji `json:"emoji"`
+	ResponseMetaData responseMetaData      `json:"response_metadata"`
+	SlackResponse
+}
+
+func (api *Client) adminEmojiRequest(ctx context.Context, method string, values url.Values) error {
+	response := &SlackResponse{}
+	if err := api.postMethod(ctx, method, values, response); err != nil {
+		return err
+	}
+
+	return response.Err()
+}
+
+// AddAdminEmoji adds an emoji, from the image at the given URL, across an Enterprise Grid organization.
+func (api *Client) AddAdminEmoji(name, emojiURL string) error {
+	return api.AddAdminEmojiContext(context.Background(), name, emojiURL)
+}
+
+// AddAdminEmojiContext adds an emoji across an Enterprise Grid organization with a custom context.
+func (api *Client) AddAdminEmojiContext(ctx context.Context, name, emojiURL string) error {
+	values := url.Values{
+		"token": {api.token},
+		"name":  {name},
+		"url":   {emojiURL},
+	}
+
+	return api.adminEmojiRequest(ctx, "admin.emoji.add", values)
+}
+
+// AddAdminEmojiAlias adds an emoji alias across an Enterprise Grid organization.
+func (api *Client) AddAdminEmojiAlias(name, aliasFor string) error {
+	return api.AddAdminEmojiAliasContext(context.Background(), name, aliasFor)
+}
+
+// AddAdminEmojiAliasContext adds an emoji alias across an Enterprise Grid organization with a custom context.
+func (api *Client) AddAdminEmojiAliasContext(ctx context.Context, name, aliasFor string) error {
+	values := url.Values{
+		"token":     {api.token},
+		"name":      {name},
+		"alias_for": {aliasFor},
+	}
+
+	return api.adminEmojiRequest(ctx, "admin.emoji.addAlias", values)
+}
+
+// ListAdminEmoji lists the emoji of an Enterprise Grid organization, keyed by name, along with the next cursor.
+func (api *Client) ListAdminEmoji(cursor string, limit int) (map[string]AdminEmoji, string, error) {
+	return api.ListAdminEmojiContext(context.Background(), cursor, limit)
+}
+
+// ListAdminEmojiContext lists the emoji of an Enterprise Grid organization with a custom context.
+func (api *Client) ListAdminEmojiContext(ctx context.Context, cursor string, limit int) (map[string]AdminEmoji, string, error) {
+	values := url.Values{
+		"token": {api.token},
+	}
+	if cursor != "" {
+		values.Add("cursor", cursor)
+	}
+	if limit != 0 {
+		values.Add("limit", strconv.Itoa(limit))
+	}
+
+	response := &adminEmojiListResponse{}
+	if err := api.postMethod(ctx, "admin.emoji.list", values, response); err != nil {
+		return nil, "", err
+	}
+
+	return response.Emoji, response.ResponseMetaData.NextCursor, response.Err()
+}
+
+// RemoveAdminEmoji removes an emoji across an Enterprise Grid organization.
+func (api *Client) RemoveAdminEmoji(name string) error {
+	return api.RemoveAdminEmojiContext(context.Background(), name)
+}
+
+// RemoveAdminEmojiContext removes an emoji across an Enterprise Grid organization with a custom context.
+func (api *Client) RemoveAdminEmojiContext(ctx context.Context, name string) error {
+	values := url.Values{
+		"token": {api.token},
+		"name":  {name},
+	}
+
+	return api.adminEmojiRequest(ctx, "admin.emoji.remove", values)
+}
+
+// RenameAdminEmoji renames an emoji across an Enterprise Grid organization.
+func (api *Client) RenameAdminEmoji(name, newName string) error {
+	return api.RenameAdminEmojiContext(context.Background(), name, newName)
+}
+
+// RenameAdminEmojiContext renames an emoji across an Enterprise Grid organization with a custom context.
+func (api *Client) RenameAdminEmojiContext(ctx context.Context, name, newName string) error {
+	values := url.Values{
+		"token":    {api.token},
+		"name":     {name},
+		"new_name": {newName},
+	}
+
+	return api.adminEmojiRequest(ctx, "admin.emoji.rename", values)
+}
@@ -0,0 +1,85 @@
+package slack
+
+import (
+	"net/http"
+	"testing"
+)
+
+func TestAdminEmoji(t *testing.T) {
+	calls := map[string]string{}
+	record := func(method string, fields ...string) http.HandlerFunc {
+		return func(rw http.ResponseWriter, r *http.Request) {
+			var got string
+			for _, field := range fields {
+				got += field + "=" + r.FormValue(field) + ";"
+			}
+			calls[method] = got
+			rw.Header().Set("Content-Type", "application/json")
+			rw.Write([]byte(`{"ok": true}`))
+		}
+	}
+	http.HandleFunc("/admin.emoji.add", record("add", "name", "url"))
+	http.HandleFunc("/admin.emoji.addAlias", record("addAlias", "name", "alias_for"))
+	http.HandleFunc("/admin.emoji.remove", record("remove", "name"))
+	http.HandleFunc("/admin.emoji.rename", record("rename", "name", "new_name"))
+
+	once.Do(startServer)
+	api := New("testing-token", OptionAPIURL("http://"+serverAddr+"/"))
+
+	if err := api.AddAdminEmoji("party", "https://example.com/party.png"); err != nil {
+		t.Fatalf("Unexpected error: %s", err)
+	}
+	if err := api.AddAdminEmojiAlias("fiesta", "party"); err != nil {
+		t.Fatalf("Unexpected error: %s", err)
+	}
+	if err := api.RenameAdminEmoji("party", "partying"); err != nil {
+		t.Fatalf("Unexpected error: %s", err)
+	}
+	if err := api.RemoveAdminEmoji("fiesta"); err != nil {
+		t.Fatalf("Unexpected error: %s", err)
+	}
+
+	expected := map[string]string{
+		"add":      "name=party;url=https://example.com/party.png;",
+		"addAlias": "name=fiesta;alias_for=party;",
+		"rename":   "name=party;new_name=partying;",
+		"remove":   "name=fiesta;",
+	}
+	for method, want := range expected {
+		if calls[method] != want {
+			t.Errorf("admin.emoji.%s: expected %q, got %q", method, want, calls[method])
+		}
+	}
+}
+
+func TestListAdminEmoji(t *testing.T) {
+	http.HandleFunc("/admin.emoji.list", func(rw http.ResponseWriter, r *http.Request) {
+		rw.Header().Set("Content-Type", "application/json")
+		if r.FormValue("limit") != "2" {
+			rw.Write([]byte(`{"ok": false, "error": "invalid_limit"}`))
+			return
+		}
+		rw.Write([]byte(`{
+			"ok": true,
+			"emoji": {
+				"party": {"url": "https://emoji.slack-edge.com/party.png", "date_created": 1574370021, "uploaded_by": "W1"}
+			},
+			"response_metadata": {"next_cursor": "next"}
+		}`))
+	})
+
+	once.Do(startServer)
+	api := New("testing-token", OptionAPIURL("http://"+serverAddr+"/"))
+
+	emoji, cursor, err := api.ListAdminEmoji("", 2)
+	if err != nil {
+		t.Fatalf("Unexpected error: %s", err)
+	}
+	if cursor != "next" {
+		t.Errorf("Expected cursor next, got %s", cursor)
+	}
+	party, ok := emoji["party"]
+	if !ok || party.UploadedBy != "W1" || party.DateCreated != 1574370021 || party.URL != "https://emoji.slack-edge.com/party.png" {
+		t.Errorf("Unexpected emoji: %#v", emoji)
+	}
+}
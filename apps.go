@@ -0,0 +1,48 @@
+package slack
+
+import (
+	"context"
+	"net/url"
+)
+
+// UninstallApp uninstalls the app from the workspace the client's token belongs to.
+// The token is revoked as part of the uninstall.
+func (api *Client) UninstallApp(clientID, clientSecret string) error {
+	return api.UninstallAppContext(context.Background(), clientID, clientSecret)
+}
+
+// UninstallAppContext uninstalls the app from the workspace the client's token belongs to with a custom context.
+func (api *Client) UninstallAppContext(ctx context.Context, clientID, clientSecret string) error {
+	values := url.Values{
+		"token":         {api.token},
+		"client_id":     {clientID},
+		"client_secret": {clientSecret},
+	}
+
+	response := SlackResponse{}
+	if err := api.postMethod(ctx, "apps.uninstall", values, &response); err != nil {
+		return err
+	}
+
+	return response.Err()
+}
+
+// RevokeTokens revokes each of the given tokens, for instance the bot and user tokens
+// obtained while installing an app. It stops at the first token that fails to be revoked.
+func (api *Client) RevokeTokens(tokens ...string) error {
+	return api.RevokeTokensContext(context.Background(), tokens...)
+}
+
+// RevokeTokensContext revokes each of the given tokens with a custom context.
+func (api *Client) RevokeTokensContext(ctx context.Context, tokens ...string) error {
+	for _, token := range tokens {
+		if token == "" {
+			continue
+		}
+		if _, err := api.SendAuthRevokeContext(ctx, token); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,58 @@
+package slack
+
+import (
+	"net/http"
+	"testing"
+)
+
+func TestUninstallApp(t *testing.T) {
+	http.HandleFunc("/apps.uninstall", func(rw http.ResponseWriter, r *http.Request) {
+		rw.Header().Set("Content-Type", "application/json")
+		if r.FormValue("client_id") != "client" || r.FormValue("client_secret") != "secret" {
+			rw.Write([]byte(`{"ok": false, "error": "invalid_client_id"}`))
+			return
+		}
+		rw.Write([]byte(`{"ok": true}`))
+	})
+
+	once.Do(startServer)
+	api := New("testing-token", OptionAPIURL("http://"+serverAddr+"/"))
+
+	if err := api.UninstallApp("client", "secret"); err != nil {
+		t.Fatalf("Unexpected error: %s", err)
+	}
+	if err := api.UninstallApp("client", "wrong"); err == nil || err.Error() != "invalid_client_id" {
+		t.Fatalf("Expected invalid_client_id, got %v", err)
+	}
+}
+
+func TestRevokeTokens(t *testing.T) {
+	var revoked []string
+	http.HandleFunc("/auth.revoke", func(rw http.ResponseWriter, r *http.Request) {
+		rw.Header().Set("Content-Type", "application/json")
+		if r.FormValue("token") == "invalid" {
+			rw.Write([]byte(`{"ok": false, "error": "invalid_auth"}`))
+			return
+		}
+		revoked = append(revoked, r.FormValue("token"))
+		rw.Write([]byte(`{"ok": true, "revoked": true}`))
+	})
+	defer func() { http.DefaultServeMux = new(http.ServeMux) }()
+
+	once.Do(startServer)
+	api := New("testing-token", OptionAPIURL("http://"+serverAddr+"/"))
+
+	if err := api.RevokeTokens("xoxb-bot", "", "xoxp-user"); err != nil {
+		t.Fatalf("Unexpected error: %s", err)
+	}
+	if len(revoked) != 2 || revoked[0] != "xoxb-bot" || revoked[1] != "xoxp-user" {
+		t.Fatalf("Unexpected revoked tokens: %v", revoked)
+	}
+
+	if err := api.RevokeTokens("invalid", "xoxb-other"); err == nil {
+		t.Fatal("Expected an error")
+	}
+	if len(revoked) != 2 {
+		t.Fatalf("Expected revocation to stop at the first error, got %v", revoked)
+	}
+}
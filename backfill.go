@@ -0,0 +1,225 @@
+package slack
+
+import (
+	"context"
+	"strconv"
+	"sync"
+	"time"
+)
+
+// Defaults used by Backfill.
+const (
+	DefaultBackfillConcurrency = 4
+	// DefaultBackfillRequestsPerMinute matches the budget of Tier 3 methods such as conversations.history.
+	DefaultBackfillRequestsPerMinute = 50
+	DefaultBackfillPageSize          = 200
+)
+
+// BackfillCheckpoint records the progress of the backfill of a channel.
+type BackfillCheckpoint struct {
+	// Oldest is the timestamp up to which the channel has been entirely backfilled.
+	Oldest string
+	// Latest and Cursor describe a backfill in progress, they're empty once it completed.
+	Latest string
+	Cursor string
+}
+
+// BackfillCheckpointer persists checkpoints so an interrupted backfill resumes where it stopped.
+type BackfillCheckpointer interface {
+	// LoadCheckpoint returns the checkpoint of the channel, the zero value when there is none.
+	LoadCheckpoint(ctx context.Context, channelID string) (BackfillCheckpoint, error)
+	SaveCheckpoint(ctx context.Context, channelID string, checkpoint BackfillCheckpoint) error
+}
+
+// BackfillHandler receives each page of messages fetched by Backfill, newest first.
+// The checkpoint following the page is only saved once the handler returned successfully.
+type BackfillHandler func(ctx context.Context, channelID string, msgs []Message) error
+
+// BackfillParameters contains arguments for Backfill method call
+type BackfillParameters struct {
+	Channels []string
+	// Oldest bounds the history fetched for channels without a checkpoint.
+	Oldest string
+	// Latest excludes the messages posted after this timestamp, the history is fetched up to
+	// the start of the run when empty. Interrupted runs resume with their own bound.
+	Latest string
+	// Concurrency is the number of channels fetched at once, DefaultBackfillConcurrency when zero.
+	Concurrency int
+	// RequestsPerMinute is shared by all the channels, DefaultBackfillRequestsPerMinute when zero.
+	RequestsPerMinute int
+	// PageSize is the number of messages requested at once, DefaultBackfillPageSize when zero.
+	PageSize int
+	// Checkpoints is optional, without it every run fetches the whole history.
+	Checkpoints BackfillCheckpointer
+}
+
+// Backfill fetches the history of many channels concurrently, passing every page of messages to handle.
+// Each run fetches the messages posted since the previous run completed, resuming an interrupted run
+// from its last checkpoint. Requests are spread to stay within the rate limit, rate limited requests are
+// retried. The first error stops the backfill of every channel and is returned.
+func (api *Client) Backfill(params BackfillParameters, handle BackfillHandler) error {
+	return api.BackfillContext(context.Background(), params, handle)
+}
+
+// BackfillContext fetches the history of many channels concurrently with a custom context.
+// For more details, see Backfill documentation.
+func (api *Client) BackfillContext(ctx context.Context, params BackfillParameters, handle BackfillHandler) error {
+	if params.Concurrency <= 0 {
+		params.Concurrency = DefaultBackfillConcurrency
+	}
+	if params.RequestsPerMinute <= 0 {
+		params.RequestsPerMinute = DefaultBackfillRequestsPerMinute
+	}
+	if params.PageSize <= 0 {
+		params.PageSize = DefaultBackfillPageSize
+	}
+
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	var (
+		wg       sync.WaitGroup
+		once     sync.Once
+		firstErr error
+		channels = make(chan string)
+		b        = &backfiller{
+			api:    api,
+			params: params,
+			handle: handle,
+			budget: &requestBudget{interval: time.Minute / time.Duration(params.RequestsPerMinute), clock: api.currentClock()},
+		}
+	)
+
+	for i := 0; i < params.Concurrency; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for channelID := range channels {
+				if err := b.channel(ctx, channelID); err != nil {
+					once.Do(func() {
+						firstErr = err
+						cancel()
+					})
+				}
+			}
+		}()
+	}
+
+feed:
+	for _, channelID := range params.Channels {
+		select {
+		case channels <- channelID:
+		case <-ctx.Done():
+			break feed
+		}
+	}
+	close(channels)
+	wg.Wait()
+
+	if firstErr != nil {
+		return firstErr
+	}
+	return ctx.Err()
+}
+
+type backfiller struct {
+	api    *Client
+	params BackfillParameters
+	handle BackfillHandler
+	budget *requestBudget
+}
+
+func (t *backfiller) channel(ctx context.Context, channelID string) error {
+	checkpoint := BackfillCheckpoint{Oldest: t.params.Oldest}
+	if t.params.Checkpoints != nil {
+		saved, err := t.params.Checkpoints.LoadCheckpoint(ctx, channelID)
+		if err != nil {
+			return err
+		}
+		if saved != (BackfillCheckpoint{}) {
+			checkpoint = saved
+		}
+	}
+
+	if checkpoint.Latest == "" {
+		checkpoint.Latest = t.params.Latest
+	}
+	if checkpoint.Latest == "" {
+		checkpoint.Latest = strconv.FormatInt(t.api.currentClock().Now().Unix(), 10) + ".000000"
+	}
+
+	for {
+		var history *GetConversationHistoryResponse
+		err := retryRateLimited(ctx, t.api.currentClock(), func() (err error) {
+			if err = t.budget.wait(ctx); err != nil {
+				return err
+			}
+			history, err = t.api.GetConversationHistoryContext(ctx, &GetConversationHistoryParameters{
+				ChannelID: channelID,
+				Cursor:    checkpoint.Cursor,
+				Latest:    checkpoint.Latest,
+				Oldest:    checkpoint.Oldest,
+				Limit:     t.params.PageSize,
+			})
+			return err
+		})
+		if err != nil {
+			return err
+		}
+
+		if len(history.Messages) > 0 {
+			if err = t.handle(ctx, channelID, history.Messages); err != nil {
+				return err
+			}
+		}
+
+		checkpoint.Cursor = history.ResponseMetaData.NextCursor
+		if checkpoint.Cursor == "" {
+			checkpoint = BackfillCheckpoint{Oldest: checkpoint.Latest}
+		}
+
+		if t.params.Checkpoints != nil {
+			if err = t.params.Checkpoints.SaveCheckpoint(ctx, channelID, checkpoint); err != nil {
+				return err
+			}
+		}
+
+		if checkpoint.Cursor == "" {
+			return nil
+		}
+	}
+}
+
+// requestBudget spaces requests made from several goroutines by at least interval.
+type requestBudget struct {
+	mu       sync.Mutex
+	next     time.Time
+	interval time.Duration
+	clock    Clock
+}
+
+func (t *requestBudget) wait(ctx context.Context) error {
+	t.mu.Lock()
+	clock := t.clock
+	if clock == nil {
+		clock = systemClock{}
+	}
+
+	now := clock.Now()
+	at := t.next
+	if at.Before(now) {
+		at = now
+	}
+	t.next = at.Add(t.interval)
+	t.mu.Unlock()
+
+	if delay := at.Sub(now); delay > 0 {
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-clock.After(delay):
+		}
+	}
+
+	return nil
+}
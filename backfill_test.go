@@ -0,0 +1,122 @@
+package slack
+
+import (
+	"context"
+	"net/http"
+	"sort"
+	"sync"
+	"testing"
+	"time"
+)
+
+type memoryCheckpoints struct {
+	mu          sync.Mutex
+	checkpoints map[string]BackfillCheckpoint
+}
+
+func (t *memoryCheckpoints) LoadCheckpoint(ctx context.Context, channelID string) (BackfillCheckpoint, error) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	return t.checkpoints[channelID], nil
+}
+
+func (t *memoryCheckpoints) SaveCheckpoint(ctx context.Context, channelID string, checkpoint BackfillCheckpoint) error {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	t.checkpoints[channelID] = checkpoint
+	return nil
+}
+
+func backfillHistoryHandler(rw http.ResponseWriter, r *http.Request) {
+	rw.Header().Set("Content-Type", "application/json")
+	channel := r.FormValue("channel")
+	if r.FormValue("oldest") == "1500000000.000000" {
+		rw.Write([]byte(`{"ok": true, "messages": []}`))
+		return
+	}
+
+	switch r.FormValue("cursor") {
+	case "":
+		rw.Write([]byte(`{"ok": true, "messages": [{"ts": "3", "text": "` + channel + `-3"}, {"ts": "2", "text": "` + channel + `-2"}], "response_metadata": {"next_cursor": "page2"}}`))
+	case "page2":
+		rw.Write([]byte(`{"ok": true, "messages": [{"ts": "1", "text": "` + channel + `-1"}]}`))
+	}
+}
+
+func TestBackfill(t *testing.T) {
+	http.DefaultServeMux = new(http.ServeMux)
+	defer func() { http.DefaultServeMux = new(http.ServeMux) }()
+
+	http.HandleFunc("/conversations.history", backfillHistoryHandler)
+	once.Do(startServer)
+	api := New(validToken, OptionAPIURL("http://"+serverAddr+"/"))
+
+	var (
+		mu    sync.Mutex
+		texts []string
+	)
+	handle := func(ctx context.Context, channelID string, msgs []Message) error {
+		mu.Lock()
+		defer mu.Unlock()
+		for _, msg := range msgs {
+			texts = append(texts, msg.Text)
+		}
+		return nil
+	}
+
+	checkpoints := &memoryCheckpoints{checkpoints: map[string]BackfillCheckpoint{
+		// C2 was interrupted after its first page.
+		"C2": {Latest: "1600000000.000000", Cursor: "page2"},
+		// C3 is up to date.
+		"C3": {Oldest: "1500000000.000000"},
+	}}
+
+	err := api.Backfill(BackfillParameters{
+		Channels:          []string{"C1", "C2", "C3"},
+		Concurrency:       2,
+		RequestsPerMinute: 6000,
+		Checkpoints:       checkpoints,
+	}, handle)
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	sort.Strings(texts)
+	want := []string{"C1-1", "C1-2", "C1-3", "C2-1"}
+	if len(texts) != len(want) {
+		t.Fatalf("expected %v, got %v", want, texts)
+	}
+	for i := range want {
+		if texts[i] != want[i] {
+			t.Fatalf("expected %v, got %v", want, texts)
+		}
+	}
+
+	if got := checkpoints.checkpoints["C2"]; got != (BackfillCheckpoint{Oldest: "1600000000.000000"}) {
+		t.Errorf("unexpected checkpoint for C2: %+v", got)
+	}
+	if got := checkpoints.checkpoints["C1"]; got.Oldest == "" || got.Cursor != "" {
+		t.Errorf("expected C1 to be complete, got %+v", got)
+	}
+}
+
+func TestRequestBudget(t *testing.T) {
+	budget := &requestBudget{interval: 20 * time.Millisecond}
+
+	start := time.Now()
+	for i := 0; i < 3; i++ {
+		if err := budget.wait(context.Background()); err != nil {
+			t.Fatalf("unexpected error: %s", err)
+		}
+	}
+	if elapsed := time.Since(start); elapsed < 40*time.Millisecond {
+		t.Errorf("expected the requests to be spaced, took %s", elapsed)
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	budget.next = time.Now().Add(time.Hour)
+	if err := budget.wait(ctx); err != context.Canceled {
+		t.Errorf("expected %v, got %v", context.Canceled, err)
+	}
+}
@@ -1,5 +1,11 @@
 package slack
 
+import (
+	"net/url"
+	"strconv"
+	"time"
+)
+
 // @NOTE: Blocks are in beta and subject to change.
 
 // More Information: https://api.slack.com/block-kit
@@ -9,13 +15,18 @@ package slack
 type MessageBlockType string
 
 const (
-	MBTSection MessageBlockType = "section"
-	MBTDivider MessageBlockType = "divider"
-	MBTImage   MessageBlockType = "image"
-	MBTAction  MessageBlockType = "actions"
-	MBTContext MessageBlockType = "context"
-	MBTFile    MessageBlockType = "file"
-	MBTInput   MessageBlockType = "input"
+	MBTSection  MessageBlockType = "section"
+	MBTDivider  MessageBlockType = "divider"
+	MBTImage    MessageBlockType = "image"
+	MBTAction   MessageBlockType = "actions"
+	MBTContext  MessageBlockType = "context"
+	MBTFile     MessageBlockType = "file"
+	MBTInput    MessageBlockType = "input"
+	MBTHeader   MessageBlockType = "header"
+	MBTVideo    MessageBlockType = "video"
+	MBTRichText MessageBlockType = "rich_text"
+	MBTCall     MessageBlockType = "call"
+	MBTMarkdown MessageBlockType = "markdown"
 )
 
 // Block defines an interface all block types should implement
@@ -47,11 +58,14 @@ type BlockAction struct {
 	SelectedConversation  string              `json:"selected_conversation"`
 	SelectedConversations []string            `json:"selected_conversations"`
 	SelectedDate          string              `json:"selected_date"`
+	SelectedTime          string              `json:"selected_time"`
+	SelectedDateTime      int64               `json:"selected_date_time"`
 	InitialOption         OptionBlockObject   `json:"initial_option"`
 	InitialUser           string              `json:"initial_user"`
 	InitialChannel        string              `json:"initial_channel"`
 	InitialConversation   string              `json:"initial_conversation"`
 	InitialDate           string              `json:"initial_date"`
+	InitialTime           string              `json:"initial_time"`
 }
 
 // actionType returns the type of the action
@@ -59,6 +73,47 @@ func (b BlockAction) actionType() actionType {
 	return b.Type
 }
 
+// IntValue parses the value of the action, e.g. submitted by a number input which doesn't allow decimals.
+func (b BlockAction) IntValue() (int64, error) {
+	return strconv.ParseInt(b.Value, 10, 64)
+}
+
+// FloatValue parses the value of the action, e.g. submitted by a number input.
+func (b BlockAction) FloatValue() (float64, error) {
+	return strconv.ParseFloat(b.Value, 64)
+}
+
+// SelectedDateTimeAsTime returns the date and time selected with a date time picker, the zero time when there is none.
+func (b BlockAction) SelectedDateTimeAsTime() time.Time {
+	if b.SelectedDateTime == 0 {
+		return time.Time{}
+	}
+	return time.Unix(b.SelectedDateTime, 0)
+}
+
+// URLValue parses the value of the action, e.g. submitted by a URL input. ErrInvalidURL is
+// returned when the value isn't an absolute http or https URL, e.g. to be reported as a
+// validation error of the input block.
+func (b BlockAction) URLValue() (*url.URL, error) {
+	u, err := url.Parse(b.Value)
+	if err != nil || (u.Scheme != "http" && u.Scheme != "https") || u.Host == "" {
+		return nil, ErrInvalidURL
+	}
+	return u, nil
+}
+
+// SelectedOptionOf returns the option of options which was selected, e.g. the options of the
+// overflow menu of the action, or nil when none matches. The payload of the action only holds
+// the text and value of the option, the returned option also holds its URL and description.
+func (b BlockAction) SelectedOptionOf(options []*OptionBlockObject) *OptionBlockObject {
+	for _, option := range options {
+		if option != nil && option.Value == b.SelectedOption.Value {
+			return option
+		}
+	}
+	return nil
+}
+
 // NewBlockMessage creates a new Message that contains one or more blocks to be displayed
 func NewBlockMessage(blocks ...Block) Message {
 	return Message{
@@ -1,6 +1,7 @@
 package slack
 
 import (
+	"encoding/json"
 	"testing"
 
 	"github.com/stretchr/testify/assert"
@@ -17,3 +18,32 @@ func TestNewActionBlock(t *testing.T) {
 	assert.Equal(t, len(actionBlock.Elements.ElementSet), 1)
 
 }
+
+func TestBlockActionSelectedOptionOf(t *testing.T) {
+	docs := NewOptionBlockObject("docs", NewTextBlockObject("plain_text", "Docs", false, false)).
+		WithURL("https://example.com/docs").
+		WithDescription(NewTextBlockObject("plain_text", "Read the docs", false, false))
+	archive := NewOptionBlockObject("archive", NewTextBlockObject("plain_text", "Archive", false, false))
+	overflow := NewOverflowBlockElement("more", docs, archive)
+
+	payload := `{"type":"overflow","action_id":"more","selected_option":{"text":{"type":"plain_text","text":"Docs"},"value":"docs","url":"https://example.com/docs"}}`
+	var action BlockAction
+	if err := json.Unmarshal([]byte(payload), &action); err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	assert.Equal(t, "https://example.com/docs", action.SelectedOption.URL)
+	selected := action.SelectedOptionOf(overflow.Options)
+	assert.Equal(t, docs, selected)
+	assert.Equal(t, "Read the docs", selected.Description.Text)
+
+	action.SelectedOption.Value = "unknown"
+	assert.Nil(t, action.SelectedOptionOf(overflow.Options))
+
+	marshalled, err := json.Marshal(overflow)
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	expected := `{"type":"overflow","action_id":"more","options":[{"text":{"type":"plain_text","text":"Docs"},"value":"docs","description":{"type":"plain_text","text":"Read the docs"},"url":"https://example.com/docs"},{"text":{"type":"plain_text","text":"Archive"},"value":"archive"}]}`
+	assert.Equal(t, expected, string(marshalled))
+}
@@ -0,0 +1,61 @@
+package slack
+
+// CallBlock defines a block displaying a call, as added to messages by calls.add.
+//
+// More Information: https://api.slack.com/apis/calls#post_to_channel
+type CallBlock struct {
+	Type                   MessageBlockType `json:"type"`
+	BlockID                string           `json:"block_id,omitempty"`
+	CallID                 string           `json:"call_id"`
+	APIDecorationAvailable bool             `json:"api_decoration_available,omitempty"`
+	Call                   *CallBlockData   `json:"call,omitempty"`
+}
+
+// BlockType returns the type of the block
+func (s CallBlock) BlockType() MessageBlockType {
+	return s.Type
+}
+
+// NewCallBlock returns a new instance of a call block
+func NewCallBlock(callID string) *CallBlock {
+	return &CallBlock{
+		Type:   MBTCall,
+		CallID: callID,
+	}
+}
+
+// CallBlockData is the call embedded in a call block by slack, keyed by the version of its format.
+type CallBlockData struct {
+	V1 *CallBlockDataV1 `json:"v1,omitempty"`
+}
+
+// CallBlockDataV1 describes a call, its participants and state.
+type CallBlockDataV1 struct {
+	ID                       string            `json:"id"`
+	AppID                    string            `json:"app_id,omitempty"`
+	AppIconURLs              map[string]string `json:"app_icon_urls,omitempty"`
+	DateStart                JSONTime          `json:"date_start"`
+	DateEnd                  JSONTime          `json:"date_end"`
+	ActiveParticipants       []CallParticipant `json:"active_participants,omitempty"`
+	AllParticipants          []CallParticipant `json:"all_participants,omitempty"`
+	DisplayID                string            `json:"display_id,omitempty"`
+	JoinURL                  string            `json:"join_url,omitempty"`
+	DesktopAppJoinURL        string            `json:"desktop_app_join_url,omitempty"`
+	Name                     string            `json:"name,omitempty"`
+	CreatedBy                string            `json:"created_by,omitempty"`
+	Channels                 []string          `json:"channels,omitempty"`
+	IsDMCall                 bool              `json:"is_dm_call,omitempty"`
+	WasRejected              bool              `json:"was_rejected,omitempty"`
+	WasMissed                bool              `json:"was_missed,omitempty"`
+	WasAcceptedByDMRecipient bool              `json:"was_accepted_by_dm_recipient,omitempty"`
+	HasEnded                 bool              `json:"has_ended"`
+}
+
+// CallParticipant is a participant of a call, either a slack user identified by SlackID,
+// or an external user identified by ExternalID.
+type CallParticipant struct {
+	SlackID     string `json:"slack_id,omitempty"`
+	ExternalID  string `json:"external_id,omitempty"`
+	DisplayName string `json:"display_name,omitempty"`
+	AvatarURL   string `json:"avatar_url,omitempty"`
+}
@@ -0,0 +1,47 @@
+package slack
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNewCallBlock(t *testing.T) {
+	callBlock := NewCallBlock("R123")
+	assert.Equal(t, string(callBlock.Type), "call")
+	assert.Equal(t, callBlock.CallID, "R123")
+}
+
+func TestCallBlockUnmarshal(t *testing.T) {
+	payload := `[{"type":"call","block_id":"2Nb","call_id":"R123","api_decoration_available":true,"call":{"v1":{
+		"id":"R123","app_id":"A123","app_icon_urls":{"image_32":"https://example.com/32.png"},
+		"date_start":1591012800,"date_end":0,
+		"active_participants":[{"slack_id":"U123"}],
+		"all_participants":[{"slack_id":"U123"},{"external_id":"guest-1","display_name":"Guest","avatar_url":"https://example.com/guest.png"}],
+		"display_id":"123-456","join_url":"https://example.com/join/123","name":"Standup","created_by":"U123",
+		"channels":["C123"],"has_ended":false
+	}}}]`
+
+	var blocks Blocks
+	if err := json.Unmarshal([]byte(payload), &blocks); err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	call, ok := blocks.BlockSet[0].(*CallBlock)
+	if !assert.True(t, ok, "expected a *CallBlock, got %T", blocks.BlockSet[0]) {
+		return
+	}
+	assert.Equal(t, "R123", call.CallID)
+	if assert.NotNil(t, call.Call) && assert.NotNil(t, call.Call.V1) {
+		assert.Equal(t, "Standup", call.Call.V1.Name)
+		assert.Equal(t, JSONTime(1591012800), call.Call.V1.DateStart)
+		assert.Equal(t, CallParticipant{ExternalID: "guest-1", DisplayName: "Guest", AvatarURL: "https://example.com/guest.png"}, call.Call.V1.AllParticipants[1])
+	}
+
+	marshalled, err := json.Marshal(blocks)
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	assert.JSONEq(t, payload, string(marshalled))
+}
@@ -1,11 +1,6 @@
 package slack
 
-import (
-	"encoding/json"
-	"fmt"
-
-	"github.com/pkg/errors"
-)
+import "encoding/json"
 
 type sumtype struct {
 	TypeVal string `json:"type"`
@@ -53,18 +48,28 @@ func (b *Blocks) UnmarshalJSON(data []byte) error {
 		switch blockType {
 		case "actions":
 			block = &ActionBlock{}
+		case "call":
+			block = &CallBlock{}
 		case "context":
 			block = &ContextBlock{}
 		case "divider":
 			block = &DividerBlock{}
 		case "file":
 			block = &FileBlock{}
+		case "header":
+			block = &HeaderBlock{}
 		case "image":
 			block = &ImageBlock{}
 		case "input":
 			block = &InputBlock{}
+		case "markdown":
+			block = &MarkdownBlock{}
+		case "rich_text":
+			block = &RichTextBlock{}
 		case "section":
 			block = &SectionBlock{}
+		case "video":
+			block = &VideoBlock{}
 		default:
 			block = &UnknownBlock{}
 		}
@@ -74,6 +79,10 @@ func (b *Blocks) UnmarshalJSON(data []byte) error {
 			return err
 		}
 
+		if unknown, ok := block.(*UnknownBlock); ok {
+			unknown.raw = r
+		}
+
 		blocks.BlockSet = append(blocks.BlockSet, block)
 	}
 
@@ -105,8 +114,18 @@ func (b *InputBlock) UnmarshalJSON(data []byte) error {
 	switch s.TypeVal {
 	case "datepicker":
 		e = &DatePickerBlockElement{}
+	case "datetimepicker":
+		e = &DateTimePickerBlockElement{}
+	case "timepicker":
+		e = &TimePickerBlockElement{}
 	case "plain_text_input":
 		e = &PlainTextInputBlockElement{}
+	case "number_input":
+		e = &NumberInputBlockElement{}
+	case "email_text_input":
+		e = &EmailTextInputBlockElement{}
+	case "url_text_input":
+		e = &URLTextInputBlockElement{}
 	case "static_select", "external_select", "users_select", "conversations_select", "channels_select":
 		e = &SelectBlockElement{}
 	case "multi_static_select", "multi_external_select", "multi_users_select", "multi_conversations_select", "multi_channels_select":
@@ -118,7 +137,7 @@ func (b *InputBlock) UnmarshalJSON(data []byte) error {
 	case "radio_buttons":
 		e = &RadioButtonsBlockElement{}
 	default:
-		return errors.New("unsupported block element type")
+		return &UnknownTypeError{Kind: "block element", Type: s.TypeVal, JSON: a.Element}
 	}
 
 	if err := json.Unmarshal(a.Element, e); err != nil {
@@ -173,20 +192,34 @@ func (b *BlockElements) UnmarshalJSON(data []byte) error {
 			blockElement = &ImageBlockElement{}
 		case "button":
 			blockElement = &ButtonBlockElement{}
+		case "workflow_button":
+			blockElement = &WorkflowButtonBlockElement{}
 		case "overflow":
 			blockElement = &OverflowBlockElement{}
 		case "datepicker":
 			blockElement = &DatePickerBlockElement{}
+		case "datetimepicker":
+			blockElement = &DateTimePickerBlockElement{}
+		case "timepicker":
+			blockElement = &TimePickerBlockElement{}
 		case "plain_text_input":
 			blockElement = &PlainTextInputBlockElement{}
+		case "number_input":
+			blockElement = &NumberInputBlockElement{}
+		case "email_text_input":
+			blockElement = &EmailTextInputBlockElement{}
+		case "url_text_input":
+			blockElement = &URLTextInputBlockElement{}
 		case "checkboxes":
 			blockElement = &CheckboxGroupsBlockElement{}
 		case "radio_buttons":
 			blockElement = &RadioButtonsBlockElement{}
 		case "static_select", "external_select", "users_select", "conversations_select", "channels_select":
 			blockElement = &SelectBlockElement{}
+		case "multi_static_select", "multi_external_select", "multi_users_select", "multi_conversations_select", "multi_channels_select":
+			blockElement = &MultiSelectBlockElement{}
 		default:
-			return fmt.Errorf("unsupported block element type %v", blockElementType)
+			return &UnknownTypeError{Kind: "block element", Type: blockElementType, JSON: r}
 		}
 
 		err = json.Unmarshal(r, blockElement)
@@ -215,92 +248,91 @@ func (a *Accessory) MarshalJSON() ([]byte, error) {
 // UnmarshalJSON implements the Unmarshaller interface for Accessory, so that any JSON
 // unmarshalling is delegated and proper type determination can be made before unmarshal
 func (a *Accessory) UnmarshalJSON(data []byte) error {
-	var r json.RawMessage
-
 	if string(data) == "{\"accessory\":null}" {
 		return nil
 	}
 
-	err := json.Unmarshal(data, &r)
+	element, err := unmarshalSectionAccessory(data)
 	if err != nil {
 		return err
 	}
 
-	s := sumtype{}
-	err = json.Unmarshal(r, &s)
+	*a = *NewAccessory(element)
+	return nil
+}
+
+// UnmarshalJSON implements the Unmarshaller interface for SectionBlock, so that the
+// accessory is decoded into the element matching its type.
+func (s *SectionBlock) UnmarshalJSON(data []byte) error {
+	type alias SectionBlock
+	a := struct {
+		*alias
+		Accessory json.RawMessage `json:"accessory"`
+	}{
+		alias: (*alias)(s),
+	}
+
+	if err := json.Unmarshal(data, &a); err != nil {
+		return err
+	}
+
+	s.Accessory = nil
+	if len(a.Accessory) == 0 || string(a.Accessory) == "null" {
+		return nil
+	}
+
+	accessory, err := unmarshalSectionAccessory(a.Accessory)
 	if err != nil {
 		return err
 	}
 
-	var blockElementType string
-	if s.TypeVal != "" {
-		blockElementType = s.TypeVal
+	s.Accessory = accessory
+	return nil
+}
+
+func unmarshalSectionAccessory(data []byte) (SectionAccessory, error) {
+	s := sumtype{}
+	if err := json.Unmarshal(data, &s); err != nil {
+		return nil, err
 	}
 
-	switch blockElementType {
+	var element SectionAccessory
+	switch s.TypeVal {
 	case "image":
-		element, err := unmarshalBlockElement(r, &ImageBlockElement{})
-		if err != nil {
-			return err
-		}
-		a.ImageElement = element.(*ImageBlockElement)
+		element = &ImageBlockElement{}
 	case "button":
-		element, err := unmarshalBlockElement(r, &ButtonBlockElement{})
-		if err != nil {
-			return err
-		}
-		a.ButtonElement = element.(*ButtonBlockElement)
+		element = &ButtonBlockElement{}
+	case "workflow_button":
+		element = &WorkflowButtonBlockElement{}
 	case "overflow":
-		element, err := unmarshalBlockElement(r, &OverflowBlockElement{})
-		if err != nil {
-			return err
-		}
-		a.OverflowElement = element.(*OverflowBlockElement)
+		element = &OverflowBlockElement{}
 	case "datepicker":
-		element, err := unmarshalBlockElement(r, &DatePickerBlockElement{})
-		if err != nil {
-			return err
-		}
-		a.DatePickerElement = element.(*DatePickerBlockElement)
+		element = &DatePickerBlockElement{}
+	case "timepicker":
+		element = &TimePickerBlockElement{}
 	case "plain_text_input":
-		element, err := unmarshalBlockElement(r, &PlainTextInputBlockElement{})
-		if err != nil {
-			return err
-		}
-		a.PlainTextInputElement = element.(*PlainTextInputBlockElement)
+		element = &PlainTextInputBlockElement{}
 	case "radio_buttons":
-		element, err := unmarshalBlockElement(r, &RadioButtonsBlockElement{})
-		if err != nil {
-			return err
-		}
-		a.RadioButtonsElement = element.(*RadioButtonsBlockElement)
+		element = &RadioButtonsBlockElement{}
 	case "static_select", "external_select", "users_select", "conversations_select", "channels_select":
-		element, err := unmarshalBlockElement(r, &SelectBlockElement{})
-		if err != nil {
-			return err
-		}
-		a.SelectElement = element.(*SelectBlockElement)
+		element = &SelectBlockElement{}
 	case "multi_static_select", "multi_external_select", "multi_users_select", "multi_conversations_select", "multi_channels_select":
-		element, err := unmarshalBlockElement(r, &MultiSelectBlockElement{})
-		if err != nil {
-			return err
-		}
-		a.MultiSelectElement = element.(*MultiSelectBlockElement)
+		element = &MultiSelectBlockElement{}
 	case "checkboxes":
-		element, err := unmarshalBlockElement(r, &CheckboxGroupsBlockElement{})
-		if err != nil {
-			return err
-		}
-		a.CheckboxGroupsBlockElement = element.(*CheckboxGroupsBlockElement)
+		element = &CheckboxGroupsBlockElement{}
 	default:
-		element, err := unmarshalBlockElement(r, &UnknownBlockElement{})
-		if err != nil {
-			return err
-		}
-		a.UnknownElement = element.(*UnknownBlockElement)
+		element = &UnknownBlockElement{}
 	}
 
-	return nil
+	if err := json.Unmarshal(data, element); err != nil {
+		return nil, err
+	}
+
+	if unknown, ok := element.(*UnknownBlockElement); ok {
+		unknown.raw = append(json.RawMessage(nil), data...)
+	}
+
+	return element, nil
 }
 
 func unmarshalBlockElement(r json.RawMessage, element BlockElement) (BlockElement, error) {
@@ -318,12 +350,18 @@ func toBlockElement(element *Accessory) BlockElement {
 	if element.ButtonElement != nil {
 		return element.ButtonElement
 	}
+	if element.WorkflowButtonElement != nil {
+		return element.WorkflowButtonElement
+	}
 	if element.OverflowElement != nil {
 		return element.OverflowElement
 	}
 	if element.DatePickerElement != nil {
 		return element.DatePickerElement
 	}
+	if element.TimePickerElement != nil {
+		return element.TimePickerElement
+	}
 	if element.PlainTextInputElement != nil {
 		return element.PlainTextInputElement
 	}
@@ -339,6 +377,9 @@ func toBlockElement(element *Accessory) BlockElement {
 	if element.MultiSelectElement != nil {
 		return element.MultiSelectElement
 	}
+	if element.UnknownElement != nil {
+		return element.UnknownElement
+	}
 
 	return nil
 }
@@ -396,7 +437,7 @@ func (e *ContextElements) UnmarshalJSON(data []byte) error {
 
 			e.Elements = append(e.Elements, elem.(*ImageBlockElement))
 		default:
-			return errors.New("unsupported context element type")
+			return &UnknownTypeError{Kind: "context element", Type: contextElementType, JSON: r}
 		}
 	}
 
@@ -1,5 +1,10 @@
 package slack
 
+import (
+	"encoding/json"
+	"time"
+)
+
 // https://api.slack.com/reference/messaging/block-elements
 
 const (
@@ -8,8 +13,14 @@ const (
 	METButton         MessageElementType = "button"
 	METOverflow       MessageElementType = "overflow"
 	METDatepicker     MessageElementType = "datepicker"
+	METDatetimepicker MessageElementType = "datetimepicker"
+	METTimepicker     MessageElementType = "timepicker"
+	METWorkflowButton MessageElementType = "workflow_button"
 	METPlainTextInput MessageElementType = "plain_text_input"
 	METRadioButtons   MessageElementType = "radio_buttons"
+	METNumber         MessageElementType = "number_input"
+	METEmailTextInput MessageElementType = "email_text_input"
+	METURLTextInput   MessageElementType = "url_text_input"
 
 	MixedElementImage MixedElementType = "mixed_image"
 	MixedElementText  MixedElementType = "mixed_text"
@@ -39,11 +50,36 @@ type MixedElement interface {
 	MixedElementType() MixedElementType
 }
 
+// SectionAccessory is a block element that can be used as the accessory of a section block.
+type SectionAccessory interface {
+	BlockElement
+	sectionAccessory()
+}
+
+func (ImageBlockElement) sectionAccessory()          {}
+func (ButtonBlockElement) sectionAccessory()         {}
+func (WorkflowButtonBlockElement) sectionAccessory() {}
+func (OverflowBlockElement) sectionAccessory()       {}
+func (DatePickerBlockElement) sectionAccessory()     {}
+func (TimePickerBlockElement) sectionAccessory()     {}
+func (PlainTextInputBlockElement) sectionAccessory() {}
+func (RadioButtonsBlockElement) sectionAccessory()   {}
+func (SelectBlockElement) sectionAccessory()         {}
+func (MultiSelectBlockElement) sectionAccessory()    {}
+func (CheckboxGroupsBlockElement) sectionAccessory() {}
+func (UnknownBlockElement) sectionAccessory()        {}
+
+// Accessory holds the accessory of a section block, only one of the elements is set.
+//
+// Deprecated: SectionBlock.Accessory accepts the elements directly, Accessory will be removed
+// in the next release.
 type Accessory struct {
 	ImageElement               *ImageBlockElement
 	ButtonElement              *ButtonBlockElement
+	WorkflowButtonElement      *WorkflowButtonBlockElement
 	OverflowElement            *OverflowBlockElement
 	DatePickerElement          *DatePickerBlockElement
+	TimePickerElement          *TimePickerBlockElement
 	PlainTextInputElement      *PlainTextInputBlockElement
 	RadioButtonsElement        *RadioButtonsBlockElement
 	SelectElement              *SelectBlockElement
@@ -53,16 +89,22 @@ type Accessory struct {
 }
 
 // NewAccessory returns a new Accessory for a given block element
+//
+// Deprecated: pass the element to NewSectionBlock instead.
 func NewAccessory(element BlockElement) *Accessory {
 	switch element.(type) {
 	case *ImageBlockElement:
 		return &Accessory{ImageElement: element.(*ImageBlockElement)}
 	case *ButtonBlockElement:
 		return &Accessory{ButtonElement: element.(*ButtonBlockElement)}
+	case *WorkflowButtonBlockElement:
+		return &Accessory{WorkflowButtonElement: element.(*WorkflowButtonBlockElement)}
 	case *OverflowBlockElement:
 		return &Accessory{OverflowElement: element.(*OverflowBlockElement)}
 	case *DatePickerBlockElement:
 		return &Accessory{DatePickerElement: element.(*DatePickerBlockElement)}
+	case *TimePickerBlockElement:
+		return &Accessory{TimePickerElement: element.(*TimePickerBlockElement)}
 	case *PlainTextInputBlockElement:
 		return &Accessory{PlainTextInputElement: element.(*PlainTextInputBlockElement)}
 	case *RadioButtonsBlockElement:
@@ -78,6 +120,17 @@ func NewAccessory(element BlockElement) *Accessory {
 	}
 }
 
+// ElementType returns the type of the element held by the accessory, empty when it holds none.
+func (a *Accessory) ElementType() MessageElementType {
+	element := toBlockElement(a)
+	if element == nil {
+		return ""
+	}
+	return element.ElementType()
+}
+
+func (a *Accessory) sectionAccessory() {}
+
 // BlockElements is a convenience struct defined to allow dynamic unmarshalling of
 // the "elements" value in Slack's JSON response, which varies depending on BlockElement type
 type BlockElements struct {
@@ -91,6 +144,8 @@ type BlockElements struct {
 type UnknownBlockElement struct {
 	Type     MessageElementType `json:"type"`
 	Elements BlockElements
+
+	raw json.RawMessage
 }
 
 // ElementType returns the type of the Element
@@ -171,6 +226,40 @@ func NewButtonBlockElement(actionID, value string, text *TextBlockObject) *Butto
 	}
 }
 
+// WorkflowButtonBlockElement defines a button starting a workflow through its
+// link trigger, with the customizable input parameters of the trigger.
+//
+// More Information: https://api.slack.com/reference/block-kit/block-elements#workflow_button
+type WorkflowButtonBlockElement struct {
+	Type               MessageElementType   `json:"type"`
+	Text               *TextBlockObject     `json:"text"`
+	Workflow           *WorkflowBlockObject `json:"workflow"`
+	ActionID           string               `json:"action_id,omitempty"`
+	Style              Style                `json:"style,omitempty"`
+	AccessibilityLabel string               `json:"accessibility_label,omitempty"`
+}
+
+// ElementType returns the type of the element
+func (s WorkflowButtonBlockElement) ElementType() MessageElementType {
+	return s.Type
+}
+
+// WithStyle adds styling to the button and returns the modified WorkflowButtonBlockElement
+func (s *WorkflowButtonBlockElement) WithStyle(style Style) *WorkflowButtonBlockElement {
+	s.Style = style
+	return s
+}
+
+// NewWorkflowButtonBlockElement returns an instance of a new workflow button element
+func NewWorkflowButtonBlockElement(actionID string, text *TextBlockObject, workflow *WorkflowBlockObject) *WorkflowButtonBlockElement {
+	return &WorkflowButtonBlockElement{
+		Type:     METWorkflowButton,
+		ActionID: actionID,
+		Text:     text,
+		Workflow: workflow,
+	}
+}
+
 // OptionsResponse defines the response used for select block typahead.
 //
 // More Information: https://api.slack.com/reference/block-kit/block-elements#external_multi_select
@@ -201,8 +290,10 @@ type SelectBlockElement struct {
 	InitialChannel               string                    `json:"initial_channel,omitempty"`
 	DefaultToCurrentConversation bool                      `json:"default_to_current_conversation,omitempty"`
 	ResponseURLEnabled           bool                      `json:"response_url_enabled,omitempty"`
+	Filter                       *FilterBlockObject        `json:"filter,omitempty"`
 	MinQueryLength               *int                      `json:"min_query_length,omitempty"`
 	Confirm                      *ConfirmationBlockObject  `json:"confirm,omitempty"`
+	FocusOnLoad                  bool                      `json:"focus_on_load,omitempty"`
 }
 
 // ElementType returns the type of the Element
@@ -210,6 +301,36 @@ func (s SelectBlockElement) ElementType() MessageElementType {
 	return MessageElementType(s.Type)
 }
 
+// WithMinQueryLength sets the number of characters typed before an external select queries its options
+func (s *SelectBlockElement) WithMinQueryLength(minQueryLength int) *SelectBlockElement {
+	s.MinQueryLength = &minQueryLength
+	return s
+}
+
+// WithInitialOption sets the option initially selected by a static or external select
+func (s *SelectBlockElement) WithInitialOption(option *OptionBlockObject) *SelectBlockElement {
+	s.InitialOption = option
+	return s
+}
+
+// WithInitialUser sets the ID of the user initially selected by a users select
+func (s *SelectBlockElement) WithInitialUser(userID string) *SelectBlockElement {
+	s.InitialUser = userID
+	return s
+}
+
+// WithInitialChannel sets the ID of the public channel initially selected by a channels select
+func (s *SelectBlockElement) WithInitialChannel(channelID string) *SelectBlockElement {
+	s.InitialChannel = channelID
+	return s
+}
+
+// WithInitialConversation sets the ID of the conversation initially selected by a conversations select
+func (s *SelectBlockElement) WithInitialConversation(conversationID string) *SelectBlockElement {
+	s.InitialConversation = conversationID
+	return s
+}
+
 // NewOptionsSelectBlockElement returns a new instance of SelectBlockElement for use with
 // the Options object only.
 func NewOptionsSelectBlockElement(optType string, placeholder *TextBlockObject, actionID string, options ...*OptionBlockObject) *SelectBlockElement {
@@ -242,17 +363,21 @@ func NewOptionsGroupSelectBlockElement(
 //
 // More Information: https://api.slack.com/reference/messaging/block-elements#multi_select
 type MultiSelectBlockElement struct {
-	Type                 string                    `json:"type,omitempty"`
-	Placeholder          *TextBlockObject          `json:"placeholder,omitempty"`
-	ActionID             string                    `json:"action_id,omitempty"`
-	Options              []*OptionBlockObject      `json:"options,omitempty"`
-	OptionGroups         []*OptionGroupBlockObject `json:"option_groups,omitempty"`
-	InitialOptions       []*OptionBlockObject      `json:"initial_options,omitempty"`
-	InitialUsers         []string                  `json:"initial_users,omitempty"`
-	InitialConversations []string                  `json:"initial_conversations,omitempty"`
-	InitialChannels      []string                  `json:"initial_channels,omitempty"`
-	MinQueryLength       *int                      `json:"min_query_length,omitempty"`
-	Confirm              *ConfirmationBlockObject  `json:"confirm,omitempty"`
+	Type                         string                    `json:"type,omitempty"`
+	Placeholder                  *TextBlockObject          `json:"placeholder,omitempty"`
+	ActionID                     string                    `json:"action_id,omitempty"`
+	Options                      []*OptionBlockObject      `json:"options,omitempty"`
+	OptionGroups                 []*OptionGroupBlockObject `json:"option_groups,omitempty"`
+	InitialOptions               []*OptionBlockObject      `json:"initial_options,omitempty"`
+	InitialUsers                 []string                  `json:"initial_users,omitempty"`
+	InitialConversations         []string                  `json:"initial_conversations,omitempty"`
+	InitialChannels              []string                  `json:"initial_channels,omitempty"`
+	DefaultToCurrentConversation bool                      `json:"default_to_current_conversation,omitempty"`
+	Filter                       *FilterBlockObject        `json:"filter,omitempty"`
+	MinQueryLength               *int                      `json:"min_query_length,omitempty"`
+	MaxSelectedItems             *int                      `json:"max_selected_items,omitempty"`
+	Confirm                      *ConfirmationBlockObject  `json:"confirm,omitempty"`
+	FocusOnLoad                  bool                      `json:"focus_on_load,omitempty"`
 }
 
 // ElementType returns the type of the Element
@@ -260,6 +385,36 @@ func (s MultiSelectBlockElement) ElementType() MessageElementType {
 	return MessageElementType(s.Type)
 }
 
+// WithMaxSelectedItems sets the maximum number of items that can be selected
+func (s *MultiSelectBlockElement) WithMaxSelectedItems(maxSelectedItems int) *MultiSelectBlockElement {
+	s.MaxSelectedItems = &maxSelectedItems
+	return s
+}
+
+// WithInitialOptions sets the options initially selected by a static or external multi select
+func (s *MultiSelectBlockElement) WithInitialOptions(options ...*OptionBlockObject) *MultiSelectBlockElement {
+	s.InitialOptions = options
+	return s
+}
+
+// WithInitialUsers sets the IDs of the users initially selected by a users multi select
+func (s *MultiSelectBlockElement) WithInitialUsers(userIDs ...string) *MultiSelectBlockElement {
+	s.InitialUsers = userIDs
+	return s
+}
+
+// WithInitialChannels sets the IDs of the public channels initially selected by a channels multi select
+func (s *MultiSelectBlockElement) WithInitialChannels(channelIDs ...string) *MultiSelectBlockElement {
+	s.InitialChannels = channelIDs
+	return s
+}
+
+// WithInitialConversations sets the IDs of the conversations initially selected by a conversations multi select
+func (s *MultiSelectBlockElement) WithInitialConversations(conversationIDs ...string) *MultiSelectBlockElement {
+	s.InitialConversations = conversationIDs
+	return s
+}
+
 // NewOptionsMultiSelectBlockElement returns a new instance of SelectBlockElement for use with
 // the Options object only.
 func NewOptionsMultiSelectBlockElement(optType string, placeholder *TextBlockObject, actionID string, options ...*OptionBlockObject) *MultiSelectBlockElement {
@@ -325,6 +480,7 @@ type DatePickerBlockElement struct {
 	Placeholder *TextBlockObject         `json:"placeholder,omitempty"`
 	InitialDate string                   `json:"initial_date,omitempty"`
 	Confirm     *ConfirmationBlockObject `json:"confirm,omitempty"`
+	FocusOnLoad bool                     `json:"focus_on_load,omitempty"`
 }
 
 // ElementType returns the type of the Element
@@ -340,19 +496,94 @@ func NewDatePickerBlockElement(actionID string) *DatePickerBlockElement {
 	}
 }
 
+// TimePickerBlockElement defines an element which lets users select a time of
+// day, as HH:mm. The time is interpreted in the timezone of the user unless
+// Timezone, an IANA name such as "Europe/Paris", is set.
+//
+// More Information: https://api.slack.com/reference/block-kit/block-elements#timepicker
+type TimePickerBlockElement struct {
+	Type        MessageElementType       `json:"type"`
+	ActionID    string                   `json:"action_id,omitempty"`
+	Placeholder *TextBlockObject         `json:"placeholder,omitempty"`
+	InitialTime string                   `json:"initial_time,omitempty"`
+	Timezone    string                   `json:"timezone,omitempty"`
+	Confirm     *ConfirmationBlockObject `json:"confirm,omitempty"`
+	FocusOnLoad bool                     `json:"focus_on_load,omitempty"`
+}
+
+// ElementType returns the type of the Element
+func (s TimePickerBlockElement) ElementType() MessageElementType {
+	return s.Type
+}
+
+// NewTimePickerBlockElement returns an instance of a time picker element
+func NewTimePickerBlockElement(actionID string) *TimePickerBlockElement {
+	return &TimePickerBlockElement{
+		Type:     METTimepicker,
+		ActionID: actionID,
+	}
+}
+
+// DateTimePickerBlockElement defines an element which lets users select both a
+// date and a time of day. The initial date and time is in Unix seconds, use
+// InitialTime and WithInitialTime to work with a time.Time.
+// Date time picker elements can be used in actions and input blocks.
+//
+// More Information: https://api.slack.com/reference/block-kit/block-elements#datetimepicker
+type DateTimePickerBlockElement struct {
+	Type            MessageElementType       `json:"type"`
+	ActionID        string                   `json:"action_id,omitempty"`
+	InitialDateTime int64                    `json:"initial_date_time,omitempty"`
+	Confirm         *ConfirmationBlockObject `json:"confirm,omitempty"`
+	FocusOnLoad     bool                     `json:"focus_on_load,omitempty"`
+}
+
+// ElementType returns the type of the Element
+func (s DateTimePickerBlockElement) ElementType() MessageElementType {
+	return s.Type
+}
+
+// InitialTime returns the initial date and time, the zero time when there is none.
+func (s DateTimePickerBlockElement) InitialTime() time.Time {
+	if s.InitialDateTime == 0 {
+		return time.Time{}
+	}
+	return time.Unix(s.InitialDateTime, 0)
+}
+
+// WithInitialTime sets the initial date and time, truncated to the second. The zero time clears it.
+func (s *DateTimePickerBlockElement) WithInitialTime(t time.Time) *DateTimePickerBlockElement {
+	if t.IsZero() {
+		s.InitialDateTime = 0
+	} else {
+		s.InitialDateTime = t.Unix()
+	}
+	return s
+}
+
+// NewDateTimePickerBlockElement returns an instance of a date time picker element
+func NewDateTimePickerBlockElement(actionID string) *DateTimePickerBlockElement {
+	return &DateTimePickerBlockElement{
+		Type:     METDatetimepicker,
+		ActionID: actionID,
+	}
+}
+
 // PlainTextInputBlockElement creates a field where a user can enter freeform
 // data.
 // Plain-text input elements are currently only available in modals.
 //
 // More Information: https://api.slack.com/reference/block-kit/block-elements#input
 type PlainTextInputBlockElement struct {
-	Type         MessageElementType `json:"type"`
-	ActionID     string             `json:"action_id,omitempty"`
-	Placeholder  *TextBlockObject   `json:"placeholder,omitempty"`
-	InitialValue string             `json:"initial_value,omitempty"`
-	Multiline    bool               `json:"multiline,omitempty"`
-	MinLength    int                `json:"min_length,omitempty"`
-	MaxLength    int                `json:"max_length,omitempty"`
+	Type                 MessageElementType    `json:"type"`
+	ActionID             string                `json:"action_id,omitempty"`
+	Placeholder          *TextBlockObject      `json:"placeholder,omitempty"`
+	InitialValue         string                `json:"initial_value,omitempty"`
+	Multiline            bool                  `json:"multiline,omitempty"`
+	MinLength            int                   `json:"min_length,omitempty"`
+	MaxLength            int                   `json:"max_length,omitempty"`
+	DispatchActionConfig *DispatchActionConfig `json:"dispatch_action_config,omitempty"`
+	FocusOnLoad          bool                  `json:"focus_on_load,omitempty"`
 }
 
 // ElementType returns the type of the Element
@@ -370,6 +601,93 @@ func NewPlainTextInputBlockElement(placeholder *TextBlockObject, actionID string
 	}
 }
 
+// EmailTextInputBlockElement creates a field where a user can enter an email address.
+// Email input elements are currently only available in modals.
+//
+// More Information: https://api.slack.com/reference/block-kit/block-elements#email
+type EmailTextInputBlockElement struct {
+	Type                 MessageElementType    `json:"type"`
+	ActionID             string                `json:"action_id,omitempty"`
+	Placeholder          *TextBlockObject      `json:"placeholder,omitempty"`
+	InitialValue         string                `json:"initial_value,omitempty"`
+	DispatchActionConfig *DispatchActionConfig `json:"dispatch_action_config,omitempty"`
+	FocusOnLoad          bool                  `json:"focus_on_load,omitempty"`
+}
+
+// ElementType returns the type of the Element
+func (s EmailTextInputBlockElement) ElementType() MessageElementType {
+	return s.Type
+}
+
+// NewEmailTextInputBlockElement returns an instance of an email input element
+func NewEmailTextInputBlockElement(placeholder *TextBlockObject, actionID string) *EmailTextInputBlockElement {
+	return &EmailTextInputBlockElement{
+		Type:        METEmailTextInput,
+		ActionID:    actionID,
+		Placeholder: placeholder,
+	}
+}
+
+// URLTextInputBlockElement creates a field where a user can enter a URL, the
+// submitted value is parsed with BlockAction.URLValue.
+// URL input elements are currently only available in modals.
+//
+// More Information: https://api.slack.com/reference/block-kit/block-elements#url
+type URLTextInputBlockElement struct {
+	Type                 MessageElementType    `json:"type"`
+	ActionID             string                `json:"action_id,omitempty"`
+	Placeholder          *TextBlockObject      `json:"placeholder,omitempty"`
+	InitialValue         string                `json:"initial_value,omitempty"`
+	DispatchActionConfig *DispatchActionConfig `json:"dispatch_action_config,omitempty"`
+	FocusOnLoad          bool                  `json:"focus_on_load,omitempty"`
+}
+
+// ElementType returns the type of the Element
+func (s URLTextInputBlockElement) ElementType() MessageElementType {
+	return s.Type
+}
+
+// NewURLTextInputBlockElement returns an instance of a URL input element
+func NewURLTextInputBlockElement(placeholder *TextBlockObject, actionID string) *URLTextInputBlockElement {
+	return &URLTextInputBlockElement{
+		Type:        METURLTextInput,
+		ActionID:    actionID,
+		Placeholder: placeholder,
+	}
+}
+
+// NumberInputBlockElement creates a field where a user can enter a number, the
+// submitted value is parsed with BlockAction.IntValue or BlockAction.FloatValue.
+// Number input elements are currently only available in modals.
+//
+// More Information: https://api.slack.com/reference/block-kit/block-elements#number
+type NumberInputBlockElement struct {
+	Type                 MessageElementType    `json:"type"`
+	IsDecimalAllowed     bool                  `json:"is_decimal_allowed"`
+	ActionID             string                `json:"action_id,omitempty"`
+	Placeholder          *TextBlockObject      `json:"placeholder,omitempty"`
+	InitialValue         string                `json:"initial_value,omitempty"`
+	MinValue             string                `json:"min_value,omitempty"`
+	MaxValue             string                `json:"max_value,omitempty"`
+	DispatchActionConfig *DispatchActionConfig `json:"dispatch_action_config,omitempty"`
+	FocusOnLoad          bool                  `json:"focus_on_load,omitempty"`
+}
+
+// ElementType returns the type of the Element
+func (s NumberInputBlockElement) ElementType() MessageElementType {
+	return s.Type
+}
+
+// NewNumberInputBlockElement returns an instance of a number input element
+func NewNumberInputBlockElement(placeholder *TextBlockObject, actionID string, isDecimalAllowed bool) *NumberInputBlockElement {
+	return &NumberInputBlockElement{
+		Type:             METNumber,
+		ActionID:         actionID,
+		Placeholder:      placeholder,
+		IsDecimalAllowed: isDecimalAllowed,
+	}
+}
+
 // CheckboxGroupsBlockElement defines an element which allows users to choose
 // one or more items from a list of possible options.
 //
@@ -380,6 +698,7 @@ type CheckboxGroupsBlockElement struct {
 	Options        []*OptionBlockObject     `json:"options"`
 	InitialOptions []*OptionBlockObject     `json:"initial_options,omitempty"`
 	Confirm        *ConfirmationBlockObject `json:"confirm,omitempty"`
+	FocusOnLoad    bool                     `json:"focus_on_load,omitempty"`
 }
 
 // ElementType returns the type of the Element
@@ -406,6 +725,7 @@ type RadioButtonsBlockElement struct {
 	Options       []*OptionBlockObject     `json:"options"`
 	InitialOption *OptionBlockObject       `json:"initial_option,omitempty"`
 	Confirm       *ConfirmationBlockObject `json:"confirm,omitempty"`
+	FocusOnLoad   bool                     `json:"focus_on_load,omitempty"`
 }
 
 // ElementType returns the type of the Element
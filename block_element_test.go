@@ -1,7 +1,9 @@
 package slack
 
 import (
+	"encoding/json"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/assert"
 )
@@ -111,6 +113,31 @@ func TestNewDatePickerBlockElement(t *testing.T) {
 
 }
 
+func TestDateTimePickerBlockElement(t *testing.T) {
+	initial := time.Date(2021, 3, 4, 9, 30, 15, 500, time.UTC)
+	element := NewDateTimePickerBlockElement("when").WithInitialTime(initial)
+
+	assert.Equal(t, int64(1614850215), element.InitialDateTime)
+	assert.True(t, element.InitialTime().Equal(initial.Truncate(time.Second)))
+	assert.True(t, element.WithInitialTime(time.Time{}).InitialTime().IsZero())
+	element.WithInitialTime(initial)
+
+	actions := NewActionBlock("schedule", element)
+	payload := `[{"type":"actions","block_id":"schedule","elements":[{"type":"datetimepicker","action_id":"when","initial_date_time":1614850215}]}]`
+
+	marshalled, err := json.Marshal(Blocks{BlockSet: []Block{actions}})
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	assert.JSONEq(t, payload, string(marshalled))
+
+	var blocks Blocks
+	if err := json.Unmarshal([]byte(payload), &blocks); err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	assert.Equal(t, element, blocks.BlockSet[0].(*ActionBlock).Elements.ElementSet[0])
+}
+
 func TestNewPlainTextInputBlockElement(t *testing.T) {
 
 	plainTextInputElemnet := NewPlainTextInputBlockElement(nil, "test")
@@ -140,3 +167,522 @@ func TestNewRadioButtonsBlockElement(t *testing.T) {
 	assert.Equal(t, len(radioButtonsElement.Options), 3)
 
 }
+
+func TestNewCheckboxGroupsBlockElement(t *testing.T) {
+	optionOne := NewOptionBlockObject("value-0", NewTextBlockObject("plain_text", "Option One", false, false))
+	optionTwo := NewOptionBlockObject("value-1", NewTextBlockObject("plain_text", "Option Two", false, false))
+
+	checkboxGroupsElement := NewCheckboxGroupsBlockElement("test", optionOne, optionTwo)
+
+	assert.Equal(t, string(checkboxGroupsElement.Type), "checkboxes")
+	assert.Equal(t, checkboxGroupsElement.ActionID, "test")
+	assert.Equal(t, len(checkboxGroupsElement.Options), 2)
+}
+
+func TestCheckboxGroupsBlockElementUnmarshal(t *testing.T) {
+	checkboxes := `{"type":"checkboxes","action_id":"tasks","options":[{"text":{"type":"plain_text","text":"Lint"},"value":"lint"},{"text":{"type":"plain_text","text":"Test"},"value":"test"}],"initial_options":[{"text":{"type":"plain_text","text":"Test"},"value":"test"}],"confirm":{"title":{"type":"plain_text","text":"Sure?"},"text":{"type":"plain_text","text":"Really?"},"confirm":{"type":"plain_text","text":"Yes"},"deny":{"type":"plain_text","text":"No"}}}`
+	payload := `[
+		{"type":"section","text":{"type":"mrkdwn","text":"Checks"},"accessory":` + checkboxes + `},
+		{"type":"actions","elements":[` + checkboxes + `]},
+		{"type":"input","label":{"type":"plain_text","text":"Checks"},"element":` + checkboxes + `}
+	]`
+
+	var blocks Blocks
+	if err := json.Unmarshal([]byte(payload), &blocks); err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	elements := []BlockElement{
+		blocks.BlockSet[0].(*SectionBlock).Accessory,
+		blocks.BlockSet[1].(*ActionBlock).Elements.ElementSet[0],
+		blocks.BlockSet[2].(*InputBlock).Element,
+	}
+	for _, element := range elements {
+		checkboxGroups, ok := element.(*CheckboxGroupsBlockElement)
+		if !assert.True(t, ok, "expected a *CheckboxGroupsBlockElement, got %T", element) {
+			continue
+		}
+		assert.Equal(t, "tasks", checkboxGroups.ActionID)
+		assert.Len(t, checkboxGroups.Options, 2)
+		assert.Equal(t, "test", checkboxGroups.InitialOptions[0].Value)
+		assert.NotNil(t, checkboxGroups.Confirm)
+	}
+
+	marshalled, err := json.Marshal(blocks)
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	assert.JSONEq(t, payload, string(marshalled))
+}
+
+func TestMultiSelectBlockElementWithMaxSelectedItems(t *testing.T) {
+	testOption := NewOptionBlockObject("test", NewTextBlockObject("plain_text", "Option One", false, false))
+
+	multiSelect := NewOptionsMultiSelectBlockElement(MultiOptTypeStatic, nil, "test", testOption).WithMaxSelectedItems(2)
+	assert.Equal(t, *multiSelect.MaxSelectedItems, 2)
+}
+
+func TestSelectBlockElementInitialValues(t *testing.T) {
+	option := NewOptionBlockObject("bug", NewTextBlockObject(PlainTextType, "Bug", false, false))
+
+	selects := []BlockElement{
+		NewOptionsSelectBlockElement(OptTypeStatic, nil, "label", option).WithInitialOption(option),
+		NewOptionsSelectBlockElement(OptTypeUser, nil, "owner").WithInitialUser("U1"),
+		NewOptionsSelectBlockElement(OptTypeChannels, nil, "channel").WithInitialChannel("C1"),
+		NewOptionsSelectBlockElement(OptTypeConversations, nil, "conversation").WithInitialConversation("D1"),
+		NewOptionsMultiSelectBlockElement(MultiOptTypeStatic, nil, "labels", option).WithInitialOptions(option),
+		NewOptionsMultiSelectBlockElement(MultiOptTypeUser, nil, "owners").WithInitialUsers("U1", "U2"),
+		NewOptionsMultiSelectBlockElement(MultiOptTypeChannels, nil, "channels").WithInitialChannels("C1", "C2"),
+		NewOptionsMultiSelectBlockElement(MultiOptTypeConversations, nil, "conversations").WithInitialConversations("D1", "G1"),
+	}
+
+	marshalled, err := json.Marshal(NewActionBlock("edit", selects...))
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	payload := `{"type":"actions","block_id":"edit","elements":[
+		{"type":"static_select","action_id":"label","options":[{"text":{"type":"plain_text","text":"Bug"},"value":"bug"}],"initial_option":{"text":{"type":"plain_text","text":"Bug"},"value":"bug"}},
+		{"type":"users_select","action_id":"owner","initial_user":"U1"},
+		{"type":"channels_select","action_id":"channel","initial_channel":"C1"},
+		{"type":"conversations_select","action_id":"conversation","initial_conversation":"D1"},
+		{"type":"multi_static_select","action_id":"labels","options":[{"text":{"type":"plain_text","text":"Bug"},"value":"bug"}],"initial_options":[{"text":{"type":"plain_text","text":"Bug"},"value":"bug"}]},
+		{"type":"multi_users_select","action_id":"owners","initial_users":["U1","U2"]},
+		{"type":"multi_channels_select","action_id":"channels","initial_channels":["C1","C2"]},
+		{"type":"multi_conversations_select","action_id":"conversations","initial_conversations":["D1","G1"]}
+	]}`
+	assert.JSONEq(t, payload, string(marshalled))
+}
+
+func TestMultiSelectBlockElementUnmarshal(t *testing.T) {
+	payload := `[{"type":"actions","block_id":"filters","elements":[{
+		"type":"multi_static_select",
+		"action_id":"labels",
+		"placeholder":{"type":"plain_text","text":"Labels"},
+		"option_groups":[
+			{"label":{"type":"plain_text","text":"Kind"},"options":[
+				{"text":{"type":"plain_text","text":"Bug"},"value":"bug"},
+				{"text":{"type":"plain_text","text":"Feature"},"value":"feature"}
+			]}
+		],
+		"initial_options":[{"text":{"type":"plain_text","text":"Bug"},"value":"bug"}],
+		"max_selected_items":2
+	}]}]`
+
+	var blocks Blocks
+	if err := json.Unmarshal([]byte(payload), &blocks); err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	element := blocks.BlockSet[0].(*ActionBlock).Elements.ElementSet[0]
+	multiSelect, ok := element.(*MultiSelectBlockElement)
+	if !assert.True(t, ok, "expected a *MultiSelectBlockElement, got %T", element) {
+		return
+	}
+	assert.Equal(t, MultiOptTypeStatic, multiSelect.Type)
+	assert.Len(t, multiSelect.OptionGroups[0].Options, 2)
+	assert.Equal(t, "bug", multiSelect.InitialOptions[0].Value)
+	assert.Equal(t, 2, *multiSelect.MaxSelectedItems)
+
+	marshalled, err := json.Marshal(blocks)
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	assert.JSONEq(t, payload, string(marshalled))
+}
+
+func TestMultiExternalSelectBlockElementUnmarshal(t *testing.T) {
+	element := `{"type":"multi_external_select","action_id":"repos","placeholder":{"type":"plain_text","text":"Repositories"},"min_query_length":3,"initial_options":[{"text":{"type":"plain_text","text":"slack"},"value":"slack-go/slack"}]}`
+	payload := `[
+		{"type":"actions","elements":[` + element + `]},
+		{"type":"input","label":{"type":"plain_text","text":"Repositories"},"element":` + element + `}
+	]`
+
+	var blocks Blocks
+	if err := json.Unmarshal([]byte(payload), &blocks); err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	for _, e := range []BlockElement{
+		blocks.BlockSet[0].(*ActionBlock).Elements.ElementSet[0],
+		blocks.BlockSet[1].(*InputBlock).Element,
+	} {
+		multiSelect, ok := e.(*MultiSelectBlockElement)
+		if !assert.True(t, ok, "expected a *MultiSelectBlockElement, got %T", e) {
+			continue
+		}
+		assert.Equal(t, MultiOptTypeExternal, multiSelect.Type)
+		assert.Equal(t, 3, *multiSelect.MinQueryLength)
+		assert.Equal(t, "slack-go/slack", multiSelect.InitialOptions[0].Value)
+	}
+
+	marshalled, err := json.Marshal(blocks)
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	assert.JSONEq(t, payload, string(marshalled))
+}
+
+func TestMultiConversationsSelectBlockElement(t *testing.T) {
+	multiSelect := NewOptionsMultiSelectBlockElement(MultiOptTypeConversations, NewTextBlockObject("plain_text", "Channels", false, false), "notify")
+	multiSelect.InitialConversations = []string{"C1", "G1"}
+	multiSelect.DefaultToCurrentConversation = true
+	multiSelect.Filter = NewFilterBlockObject("public", "private")
+	multiSelect.Filter.ExcludeBotUsers = true
+
+	payload := `[{"type":"section","text":{"type":"mrkdwn","text":"Notify"},"accessory":{
+		"type":"multi_conversations_select",
+		"placeholder":{"type":"plain_text","text":"Channels"},
+		"action_id":"notify",
+		"initial_conversations":["C1","G1"],
+		"default_to_current_conversation":true,
+		"filter":{"include":["public","private"],"exclude_bot_users":true}
+	}}]`
+
+	marshalled, err := json.Marshal(Blocks{BlockSet: []Block{NewSectionBlock(NewTextBlockObject("mrkdwn", "Notify", false, false), nil, multiSelect)}})
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	assert.JSONEq(t, payload, string(marshalled))
+
+	var blocks Blocks
+	if err = json.Unmarshal([]byte(payload), &blocks); err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	assert.Equal(t, multiSelect, blocks.BlockSet[0].(*SectionBlock).Accessory)
+}
+
+func TestMultiChannelsSelectBlockElementUnmarshal(t *testing.T) {
+	element := `{"type":"multi_channels_select","action_id":"announce","placeholder":{"type":"plain_text","text":"Channels"},"initial_channels":["C1","C2"],"max_selected_items":3}`
+	payload := `[
+		{"type":"section","text":{"type":"mrkdwn","text":"Announce in"},"accessory":` + element + `},
+		{"type":"actions","elements":[` + element + `]}
+	]`
+
+	var blocks Blocks
+	if err := json.Unmarshal([]byte(payload), &blocks); err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	for _, e := range []interface{}{
+		blocks.BlockSet[0].(*SectionBlock).Accessory,
+		blocks.BlockSet[1].(*ActionBlock).Elements.ElementSet[0],
+	} {
+		multiSelect, ok := e.(*MultiSelectBlockElement)
+		if !assert.True(t, ok, "expected a *MultiSelectBlockElement, got %T", e) {
+			continue
+		}
+		assert.Equal(t, MultiOptTypeChannels, multiSelect.Type)
+		assert.Equal(t, []string{"C1", "C2"}, multiSelect.InitialChannels)
+		assert.Equal(t, 3, *multiSelect.MaxSelectedItems)
+	}
+
+	marshalled, err := json.Marshal(blocks)
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	assert.JSONEq(t, payload, string(marshalled))
+}
+
+func TestExternalSelectBlockElement(t *testing.T) {
+	element := `{"type":"external_select","action_id":"repo","placeholder":{"type":"plain_text","text":"Repository"},"initial_option":{"text":{"type":"plain_text","text":"slack"},"value":"slack-go/slack"},"min_query_length":2}`
+	payload := `[
+		{"type":"section","text":{"type":"mrkdwn","text":"Repository"},"accessory":` + element + `},
+		{"type":"actions","elements":[` + element + `]},
+		{"type":"input","label":{"type":"plain_text","text":"Repository"},"element":` + element + `}
+	]`
+
+	var blocks Blocks
+	if err := json.Unmarshal([]byte(payload), &blocks); err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	expected := NewOptionsSelectBlockElement(OptTypeExternal, NewTextBlockObject(PlainTextType, "Repository", false, false), "repo").WithMinQueryLength(2)
+	expected.InitialOption = NewOptionBlockObject("slack-go/slack", NewTextBlockObject(PlainTextType, "slack", false, false))
+
+	for _, e := range []interface{}{
+		blocks.BlockSet[0].(*SectionBlock).Accessory,
+		blocks.BlockSet[1].(*ActionBlock).Elements.ElementSet[0],
+		blocks.BlockSet[2].(*InputBlock).Element,
+	} {
+		assert.Equal(t, expected, e)
+	}
+
+	marshalled, err := json.Marshal(blocks)
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	assert.JSONEq(t, payload, string(marshalled))
+}
+
+func TestConversationsSelectBlockElementUnmarshal(t *testing.T) {
+	element := `{"type":"conversations_select","action_id":"target","placeholder":{"type":"plain_text","text":"Conversation"},"initial_conversation":"C1","default_to_current_conversation":true,"response_url_enabled":true}`
+	payload := `[
+		{"type":"section","text":{"type":"mrkdwn","text":"Post to"},"accessory":` + element + `},
+		{"type":"actions","elements":[` + element + `]},
+		{"type":"input","label":{"type":"plain_text","text":"Post to"},"element":` + element + `}
+	]`
+
+	var blocks Blocks
+	if err := json.Unmarshal([]byte(payload), &blocks); err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	for _, e := range []interface{}{
+		blocks.BlockSet[0].(*SectionBlock).Accessory,
+		blocks.BlockSet[1].(*ActionBlock).Elements.ElementSet[0],
+		blocks.BlockSet[2].(*InputBlock).Element,
+	} {
+		conversationSelect, ok := e.(*SelectBlockElement)
+		if !assert.True(t, ok, "expected a *SelectBlockElement, got %T", e) {
+			continue
+		}
+		assert.Equal(t, OptTypeConversations, conversationSelect.Type)
+		assert.Equal(t, "C1", conversationSelect.InitialConversation)
+		assert.True(t, conversationSelect.DefaultToCurrentConversation)
+		assert.True(t, conversationSelect.ResponseURLEnabled)
+	}
+
+	marshalled, err := json.Marshal(blocks)
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	assert.JSONEq(t, payload, string(marshalled))
+}
+
+func TestChannelsSelectBlockElementUnmarshal(t *testing.T) {
+	element := `{"type":"channels_select","action_id":"channel","placeholder":{"type":"plain_text","text":"Channel"},"initial_channel":"C1","response_url_enabled":true}`
+	payload := `[
+		{"type":"section","text":{"type":"mrkdwn","text":"Channel"},"accessory":` + element + `},
+		{"type":"actions","elements":[` + element + `]}
+	]`
+
+	var blocks Blocks
+	if err := json.Unmarshal([]byte(payload), &blocks); err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	expected := NewOptionsSelectBlockElement(OptTypeChannels, NewTextBlockObject(PlainTextType, "Channel", false, false), "channel")
+	expected.InitialChannel = "C1"
+	expected.ResponseURLEnabled = true
+
+	assert.Equal(t, expected, blocks.BlockSet[0].(*SectionBlock).Accessory)
+	assert.Equal(t, expected, blocks.BlockSet[1].(*ActionBlock).Elements.ElementSet[0])
+
+	marshalled, err := json.Marshal(blocks)
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	assert.JSONEq(t, payload, string(marshalled))
+}
+
+func TestNumberInputBlockElement(t *testing.T) {
+	element := NewNumberInputBlockElement(NewTextBlockObject(PlainTextType, "Quantity", false, false), "quantity", false)
+	element.MinValue = "1"
+	element.MaxValue = "10"
+
+	payload := `[{"type":"input","block_id":"order","label":{"type":"plain_text","text":"Quantity"},"element":{
+		"type":"number_input",
+		"is_decimal_allowed":false,
+		"action_id":"quantity",
+		"placeholder":{"type":"plain_text","text":"Quantity"},
+		"min_value":"1",
+		"max_value":"10"
+	}}]`
+
+	marshalled, err := json.Marshal(Blocks{BlockSet: []Block{NewInputBlock("order", NewTextBlockObject(PlainTextType, "Quantity", false, false), element)}})
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	assert.JSONEq(t, payload, string(marshalled))
+
+	var blocks Blocks
+	if err := json.Unmarshal([]byte(payload), &blocks); err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	assert.Equal(t, element, blocks.BlockSet[0].(*InputBlock).Element)
+}
+
+func TestEmailTextInputBlockElement(t *testing.T) {
+	element := NewEmailTextInputBlockElement(NewTextBlockObject(PlainTextType, "you@example.com", false, false), "email")
+	element.InitialValue = "ops@example.com"
+	element.DispatchActionConfig = NewDispatchActionConfig(TriggerActionsOnEnterPressed)
+
+	input := NewInputBlock("contact", NewTextBlockObject(PlainTextType, "Email", false, false), element)
+	input.DispatchAction = true
+
+	payload := `[{"type":"input","block_id":"contact","label":{"type":"plain_text","text":"Email"},"dispatch_action":true,"element":{
+		"type":"email_text_input",
+		"action_id":"email",
+		"placeholder":{"type":"plain_text","text":"you@example.com"},
+		"initial_value":"ops@example.com",
+		"dispatch_action_config":{"trigger_actions_on":["on_enter_pressed"]}
+	}}]`
+
+	marshalled, err := json.Marshal(Blocks{BlockSet: []Block{input}})
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	assert.JSONEq(t, payload, string(marshalled))
+
+	var blocks Blocks
+	if err := json.Unmarshal([]byte(payload), &blocks); err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	assert.Equal(t, input, blocks.BlockSet[0])
+}
+
+func TestPlainTextInputBlockElementDispatchActionConfig(t *testing.T) {
+	element := NewPlainTextInputBlockElement(NewTextBlockObject(PlainTextType, "Search", false, false), "search")
+	element.DispatchActionConfig = NewDispatchActionConfig(TriggerActionsOnCharacterEntered, TriggerActionsOnEnterPressed)
+
+	input := NewInputBlock("query", NewTextBlockObject(PlainTextType, "Query", false, false), element)
+	input.DispatchAction = true
+
+	payload := `[{"type":"input","block_id":"query","label":{"type":"plain_text","text":"Query"},"dispatch_action":true,"element":{
+		"type":"plain_text_input",
+		"action_id":"search",
+		"placeholder":{"type":"plain_text","text":"Search"},
+		"dispatch_action_config":{"trigger_actions_on":["on_character_entered","on_enter_pressed"]}
+	}}]`
+
+	marshalled, err := json.Marshal(Blocks{BlockSet: []Block{input}})
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	assert.JSONEq(t, payload, string(marshalled))
+
+	var blocks Blocks
+	if err := json.Unmarshal([]byte(payload), &blocks); err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	assert.Equal(t, input, blocks.BlockSet[0])
+}
+
+func TestURLTextInputBlockElement(t *testing.T) {
+	element := NewURLTextInputBlockElement(NewTextBlockObject(PlainTextType, "https://", false, false), "link")
+	element.InitialValue = "https://example.com"
+
+	input := NewInputBlock("resource", NewTextBlockObject(PlainTextType, "Link", false, false), element)
+
+	payload := `[{"type":"input","block_id":"resource","label":{"type":"plain_text","text":"Link"},"element":{
+		"type":"url_text_input",
+		"action_id":"link",
+		"placeholder":{"type":"plain_text","text":"https://"},
+		"initial_value":"https://example.com"
+	}}]`
+
+	marshalled, err := json.Marshal(Blocks{BlockSet: []Block{input}})
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	assert.JSONEq(t, payload, string(marshalled))
+
+	var blocks Blocks
+	if err := json.Unmarshal([]byte(payload), &blocks); err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	assert.Equal(t, input, blocks.BlockSet[0])
+}
+
+func TestTimePickerBlockElement(t *testing.T) {
+	element := NewTimePickerBlockElement("at")
+	element.InitialTime = "09:30"
+	element.Timezone = "Europe/Paris"
+
+	section := NewSectionBlock(NewTextBlockObject(MarkdownType, "Daily standup", false, false), nil, element, SectionBlockOptionBlockID("standup"))
+	payload := `[{"type":"section","block_id":"standup","text":{"type":"mrkdwn","text":"Daily standup"},"accessory":{
+		"type":"timepicker",
+		"action_id":"at",
+		"initial_time":"09:30",
+		"timezone":"Europe/Paris"
+	}}]`
+
+	marshalled, err := json.Marshal(Blocks{BlockSet: []Block{section}})
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	assert.JSONEq(t, payload, string(marshalled))
+
+	var blocks Blocks
+	if err := json.Unmarshal([]byte(payload), &blocks); err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	assert.Equal(t, element, blocks.BlockSet[0].(*SectionBlock).Accessory)
+
+	assert.Equal(t, element, NewAccessory(element).TimePickerElement)
+	assert.Equal(t, METTimepicker, NewAccessory(element).ElementType())
+}
+
+func TestWorkflowButtonBlockElement(t *testing.T) {
+	workflow := NewWorkflowBlockObject("https://slack.com/shortcuts/Ft0123ABC456/xyz",
+		NewWorkflowInputParameterObject("input_parameter_a", "Value for input param A"),
+		NewWorkflowInputParameterObject("input_parameter_b", "Value for input param B"),
+	)
+	element := NewWorkflowButtonBlockElement("run", NewTextBlockObject(PlainTextType, "Run Workflow", false, false), workflow).WithStyle(StylePrimary)
+	element.AccessibilityLabel = "Start the workflow"
+
+	actions := NewActionBlock("workflows", element)
+	payload := `[{"type":"actions","block_id":"workflows","elements":[{
+		"type":"workflow_button",
+		"text":{"type":"plain_text","text":"Run Workflow"},
+		"action_id":"run",
+		"style":"primary",
+		"accessibility_label":"Start the workflow",
+		"workflow":{"trigger":{
+			"url":"https://slack.com/shortcuts/Ft0123ABC456/xyz",
+			"customizable_input_parameters":[
+				{"name":"input_parameter_a","value":"Value for input param A"},
+				{"name":"input_parameter_b","value":"Value for input param B"}
+			]
+		}}
+	}]}]`
+
+	marshalled, err := json.Marshal(Blocks{BlockSet: []Block{actions}})
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	assert.JSONEq(t, payload, string(marshalled))
+
+	var blocks Blocks
+	if err := json.Unmarshal([]byte(payload), &blocks); err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	assert.Equal(t, element, blocks.BlockSet[0].(*ActionBlock).Elements.ElementSet[0])
+
+	section := NewSectionBlock(NewTextBlockObject(MarkdownType, "Ready?", false, false), nil, element)
+	marshalled, err = json.Marshal(section)
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	var unmarshalled SectionBlock
+	if err := json.Unmarshal(marshalled, &unmarshalled); err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	assert.Equal(t, element, unmarshalled.Accessory)
+}
+
+func TestBlockElementFocusOnLoad(t *testing.T) {
+	input := NewPlainTextInputBlockElement(nil, "title")
+	input.FocusOnLoad = true
+	picker := NewDatePickerBlockElement("due")
+
+	marshalled, err := json.Marshal(Blocks{BlockSet: []Block{
+		NewInputBlock("title", NewTextBlockObject(PlainTextType, "Title", false, false), input),
+		NewInputBlock("due", NewTextBlockObject(PlainTextType, "Due", false, false), picker),
+	}})
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	payload := `[
+		{"type":"input","block_id":"title","label":{"type":"plain_text","text":"Title"},"element":{"type":"plain_text_input","action_id":"title","focus_on_load":true}},
+		{"type":"input","block_id":"due","label":{"type":"plain_text","text":"Due"},"element":{"type":"datepicker","action_id":"due"}}
+	]`
+	assert.JSONEq(t, payload, string(marshalled))
+
+	var blocks Blocks
+	if err := json.Unmarshal([]byte(payload), &blocks); err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	assert.True(t, blocks.BlockSet[0].(*InputBlock).Element.(*PlainTextInputBlockElement).FocusOnLoad)
+	assert.False(t, blocks.BlockSet[1].(*InputBlock).Element.(*DatePickerBlockElement).FocusOnLoad)
+}
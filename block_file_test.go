@@ -1,6 +1,7 @@
 package slack
 
 import (
+	"encoding/json"
 	"testing"
 
 	"github.com/stretchr/testify/assert"
@@ -13,3 +14,25 @@ func TestNewFileBlock(t *testing.T) {
 	assert.Equal(t, fileBlock.ExternalID, "external_id")
 	assert.Equal(t, fileBlock.Source, "source")
 }
+
+func TestFileBlockUnmarshal(t *testing.T) {
+	payload := `[{"type":"file","block_id":"report","external_id":"ABCD1","source":"remote"}]`
+
+	var blocks Blocks
+	if err := json.Unmarshal([]byte(payload), &blocks); err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	file, ok := blocks.BlockSet[0].(*FileBlock)
+	if !assert.True(t, ok, "expected a *FileBlock, got %T", blocks.BlockSet[0]) {
+		return
+	}
+	assert.Equal(t, "ABCD1", file.ExternalID)
+	assert.Equal(t, "remote", file.Source)
+
+	marshalled, err := json.Marshal(blocks)
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	assert.JSONEq(t, payload, string(marshalled))
+}
@@ -0,0 +1,24 @@
+package slack
+
+// HeaderBlock defines a larger, bold text used as the heading of a message or view.
+//
+// More Information: https://api.slack.com/reference/block-kit/blocks#header
+type HeaderBlock struct {
+	Type    MessageBlockType `json:"type"`
+	Text    *TextBlockObject `json:"text"`
+	BlockID string           `json:"block_id,omitempty"`
+}
+
+// BlockType returns the type of the block
+func (s HeaderBlock) BlockType() MessageBlockType {
+	return s.Type
+}
+
+// NewHeaderBlock returns a new instance of a header block, text must be a plain_text object.
+func NewHeaderBlock(textObj *TextBlockObject, blockID string) *HeaderBlock {
+	return &HeaderBlock{
+		Type:    MBTHeader,
+		Text:    textObj,
+		BlockID: blockID,
+	}
+}
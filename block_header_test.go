@@ -0,0 +1,39 @@
+package slack
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNewHeaderBlock(t *testing.T) {
+	textInfo := NewTextBlockObject("plain_text", "Budget Performance", false, false)
+
+	headerBlock := NewHeaderBlock(textInfo, "test_block")
+	assert.Equal(t, string(headerBlock.Type), "header")
+	assert.Equal(t, headerBlock.BlockID, "test_block")
+	assert.Equal(t, headerBlock.Text.Text, "Budget Performance")
+}
+
+func TestHeaderBlockUnmarshal(t *testing.T) {
+	payload := `[{"type":"header","block_id":"title","text":{"type":"plain_text","text":"Budget Performance"}}]`
+
+	var blocks Blocks
+	if err := json.Unmarshal([]byte(payload), &blocks); err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	header, ok := blocks.BlockSet[0].(*HeaderBlock)
+	if !assert.True(t, ok, "expected a *HeaderBlock, got %T", blocks.BlockSet[0]) {
+		return
+	}
+	assert.Equal(t, "title", header.BlockID)
+	assert.Equal(t, "Budget Performance", header.Text.Text)
+
+	marshalled, err := json.Marshal(blocks)
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	assert.JSONEq(t, payload, string(marshalled))
+}
@@ -10,6 +10,8 @@ type InputBlock struct {
 	Element  BlockElement     `json:"element"`
 	Hint     *TextBlockObject `json:"hint,omitempty"`
 	Optional bool             `json:"optional,omitempty"`
+	// DispatchAction makes the element dispatch a block_actions payload, see DispatchActionConfig.
+	DispatchAction bool `json:"dispatch_action,omitempty"`
 }
 
 // BlockType returns the type of the block
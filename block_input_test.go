@@ -1,6 +1,7 @@
 package slack
 
 import (
+	"encoding/json"
 	"testing"
 
 	"github.com/stretchr/testify/assert"
@@ -16,3 +17,31 @@ func TestNewInputBlock(t *testing.T) {
 	assert.Equal(t, inputBlock.Label, label)
 	assert.Equal(t, inputBlock.Element, element)
 }
+
+func TestInputBlockRoundTrip(t *testing.T) {
+	payload := `[{"type":"input","block_id":"reason","label":{"type":"plain_text","text":"Reason"},"element":{"type":"plain_text_input","action_id":"reason_input","multiline":true},"hint":{"type":"plain_text","text":"Shown to the requester"},"optional":true}]`
+
+	var blocks Blocks
+	if err := json.Unmarshal([]byte(payload), &blocks); err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	if !assert.Len(t, blocks.BlockSet, 1) {
+		return
+	}
+
+	input, ok := blocks.BlockSet[0].(*InputBlock)
+	if !assert.True(t, ok, "expected an *InputBlock, got %T", blocks.BlockSet[0]) {
+		return
+	}
+	assert.Equal(t, "reason", input.BlockID)
+	assert.Equal(t, "Reason", input.Label.Text)
+	assert.Equal(t, "Shown to the requester", input.Hint.Text)
+	assert.True(t, input.Optional)
+	assert.IsType(t, &PlainTextInputBlockElement{}, input.Element)
+
+	marshalled, err := json.Marshal(blocks)
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	assert.JSONEq(t, payload, string(marshalled))
+}
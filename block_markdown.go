@@ -0,0 +1,25 @@
+package slack
+
+// MarkdownBlock displays standard markdown text, which slack translates itself unlike the
+// mrkdwn of text objects. The text is limited to 12,000 characters.
+//
+// More Information: https://api.slack.com/reference/block-kit/blocks#markdown
+type MarkdownBlock struct {
+	Type    MessageBlockType `json:"type"`
+	Text    string           `json:"text"`
+	BlockID string           `json:"block_id,omitempty"`
+}
+
+// BlockType returns the type of the block
+func (s MarkdownBlock) BlockType() MessageBlockType {
+	return s.Type
+}
+
+// NewMarkdownBlock returns a new instance of a markdown block
+func NewMarkdownBlock(text, blockID string) *MarkdownBlock {
+	return &MarkdownBlock{
+		Type:    MBTMarkdown,
+		Text:    text,
+		BlockID: blockID,
+	}
+}
@@ -0,0 +1,37 @@
+package slack
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNewMarkdownBlock(t *testing.T) {
+	markdownBlock := NewMarkdownBlock("**Lots** of _markdown_", "test_block")
+	assert.Equal(t, string(markdownBlock.Type), "markdown")
+	assert.Equal(t, markdownBlock.BlockID, "test_block")
+	assert.Equal(t, markdownBlock.Text, "**Lots** of _markdown_")
+}
+
+func TestMarkdownBlockUnmarshal(t *testing.T) {
+	payload := `[{"type":"markdown","block_id":"answer","text":"# Title\n\n- [link](https://example.com)"}]`
+
+	var blocks Blocks
+	if err := json.Unmarshal([]byte(payload), &blocks); err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	markdown, ok := blocks.BlockSet[0].(*MarkdownBlock)
+	if !assert.True(t, ok, "expected a *MarkdownBlock, got %T", blocks.BlockSet[0]) {
+		return
+	}
+	assert.Equal(t, "answer", markdown.BlockID)
+	assert.Equal(t, "# Title\n\n- [link](https://example.com)", markdown.Text)
+
+	marshalled, err := json.Marshal(blocks)
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	assert.JSONEq(t, payload, string(marshalled))
+}
@@ -2,6 +2,9 @@ package slack
 
 import (
 	"encoding/json"
+	"fmt"
+
+	"github.com/slack-go/slack/slackutilsx"
 )
 
 // Block Objects are also known as Composition Objects
@@ -145,6 +148,34 @@ func NewTextBlockObject(elementType, text string, emoji, verbatim bool) *TextBlo
 	}
 }
 
+// NewSanitizedTextBlockObject returns a text object formatting the untrusted args according to format, like fmt.Sprintf.
+// The args are sanitized with slackutilsx.SanitizeMrkdwn for mrkdwn objects, which are also marked verbatim so URLs and
+// channel names in the args aren't linked, and with slackutilsx.SanitizePlainText for plain_text objects.
+func NewSanitizedTextBlockObject(elementType, format string, args ...interface{}) *TextBlockObject {
+	sanitize := slackutilsx.SanitizePlainText
+	if elementType == MarkdownType {
+		sanitize = slackutilsx.SanitizeMrkdwn
+	}
+
+	sanitized := make([]interface{}, len(args))
+	for i, arg := range args {
+		switch arg := arg.(type) {
+		case string:
+			sanitized[i] = sanitize(arg)
+		case fmt.Stringer:
+			sanitized[i] = sanitize(arg.String())
+		default:
+			sanitized[i] = arg
+		}
+	}
+
+	return &TextBlockObject{
+		Type:     elementType,
+		Text:     fmt.Sprintf(format, sanitized...),
+		Verbatim: elementType == MarkdownType,
+	}
+}
+
 // BlockType returns the type of the block
 func (t TextBlockObject) BlockType() MessageBlockType {
 	if t.Type == "mrkdown" {
@@ -171,9 +202,17 @@ func (s ConfirmationBlockObject) validateType() MessageObjectType {
 	return motConfirmation
 }
 
-// add styling to confirmation object
-func (s *ConfirmationBlockObject) WithStyle(style Style) {
+// WithStyle sets the style of the confirm button, StyleDanger shows a red button to
+// confirm destructive actions and StylePrimary a green one.
+func (s *ConfirmationBlockObject) WithStyle(style Style) *ConfirmationBlockObject {
 	s.Style = style
+	return s
+}
+
+// WithDeny sets the text of the button cancelling the action.
+func (s *ConfirmationBlockObject) WithDeny(deny *TextBlockObject) *ConfirmationBlockObject {
+	s.Deny = deny
+	return s
 }
 
 // NewConfirmationBlockObject returns an instance of a new Confirmation Block Object
@@ -192,7 +231,12 @@ func NewConfirmationBlockObject(title, text, confirm, deny *TextBlockObject) *Co
 type OptionBlockObject struct {
 	Text  *TextBlockObject `json:"text"`
 	Value string           `json:"value"`
-	URL   string           `json:"url,omitempty"`
+	// Description is displayed below the text of the option, it's only supported by
+	// checkboxes, radio buttons and overflow menus.
+	Description *TextBlockObject `json:"description,omitempty"`
+	// URL is opened in the browser of the user when the option is selected, it's only
+	// supported by overflow menus.
+	URL string `json:"url,omitempty"`
 }
 
 // NewOptionBlockObject returns an instance of a new Option Block Element
@@ -203,6 +247,18 @@ func NewOptionBlockObject(value string, text *TextBlockObject) *OptionBlockObjec
 	}
 }
 
+// WithDescription sets the description displayed below the text of the option.
+func (s *OptionBlockObject) WithDescription(description *TextBlockObject) *OptionBlockObject {
+	s.Description = description
+	return s
+}
+
+// WithURL sets the URL opened when the option of an overflow menu is selected.
+func (s *OptionBlockObject) WithURL(url string) *OptionBlockObject {
+	s.URL = url
+	return s
+}
+
 // validateType enforces block objects for element and block parameters
 func (s OptionBlockObject) validateType() MessageObjectType {
 	return motOption
@@ -228,3 +284,83 @@ func NewOptionGroupBlockElement(label *TextBlockObject, options ...*OptionBlockO
 		Options: options,
 	}
 }
+
+// FilterBlockObject restricts the conversations listed by conversations select menus.
+// Include lists the types of the conversations to list, among im, mpim, private and public.
+//
+// More Information: https://api.slack.com/reference/block-kit/composition-objects#filter_conversations
+type FilterBlockObject struct {
+	Include                       []string `json:"include,omitempty"`
+	ExcludeExternalSharedChannels bool     `json:"exclude_external_shared_channels,omitempty"`
+	ExcludeBotUsers               bool     `json:"exclude_bot_users,omitempty"`
+}
+
+// NewFilterBlockObject returns an instance of a new filter listing the given types of conversations
+func NewFilterBlockObject(include ...string) *FilterBlockObject {
+	return &FilterBlockObject{
+		Include: include,
+	}
+}
+
+// Interactions which make an input element dispatch a block_actions payload.
+const (
+	TriggerActionsOnEnterPressed     = "on_enter_pressed"
+	TriggerActionsOnCharacterEntered = "on_character_entered"
+)
+
+// DispatchActionConfig defines when an input element dispatches a block_actions payload,
+// for input blocks with dispatch_action set.
+//
+// More Information: https://api.slack.com/reference/block-kit/composition-objects#dispatch_action_config
+type DispatchActionConfig struct {
+	TriggerActionsOn []string `json:"trigger_actions_on,omitempty"`
+}
+
+// NewDispatchActionConfig returns an instance of a new dispatch action configuration
+func NewDispatchActionConfig(triggers ...string) *DispatchActionConfig {
+	return &DispatchActionConfig{
+		TriggerActionsOn: triggers,
+	}
+}
+
+// WorkflowBlockObject is the workflow started by a workflow button.
+//
+// More Information: https://api.slack.com/reference/block-kit/composition-objects#workflow
+type WorkflowBlockObject struct {
+	Trigger *WorkflowTriggerBlockObject `json:"trigger"`
+}
+
+// WorkflowTriggerBlockObject is the link trigger starting a workflow, along with the
+// values of the input parameters of the trigger which can be customized.
+//
+// More Information: https://api.slack.com/reference/block-kit/composition-objects#trigger
+type WorkflowTriggerBlockObject struct {
+	URL                         string                          `json:"url"`
+	CustomizableInputParameters []*WorkflowInputParameterObject `json:"customizable_input_parameters,omitempty"`
+}
+
+// WorkflowInputParameterObject is the value of an input parameter of a workflow trigger.
+//
+// More Information: https://api.slack.com/reference/block-kit/composition-objects#input_parameter
+type WorkflowInputParameterObject struct {
+	Name  string `json:"name"`
+	Value string `json:"value"`
+}
+
+// NewWorkflowBlockObject returns an instance of a new workflow started by the link trigger
+func NewWorkflowBlockObject(triggerURL string, params ...*WorkflowInputParameterObject) *WorkflowBlockObject {
+	return &WorkflowBlockObject{
+		Trigger: &WorkflowTriggerBlockObject{
+			URL:                         triggerURL,
+			CustomizableInputParameters: params,
+		},
+	}
+}
+
+// NewWorkflowInputParameterObject returns an instance of a new input parameter value
+func NewWorkflowInputParameterObject(name, value string) *WorkflowInputParameterObject {
+	return &WorkflowInputParameterObject{
+		Name:  name,
+		Value: value,
+	}
+}
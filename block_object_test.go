@@ -1,6 +1,7 @@
 package slack
 
 import (
+	"encoding/json"
 	"testing"
 
 	"github.com/stretchr/testify/assert"
@@ -42,6 +43,22 @@ func TestNewConfirmationBlockObject(t *testing.T) {
 
 }
 
+func TestConfirmationBlockObjectBuilders(t *testing.T) {
+	confirmation := NewConfirmationBlockObject(
+		NewTextBlockObject(PlainTextType, "Delete the channel?", false, false),
+		NewTextBlockObject(PlainTextType, "Its messages will be lost.", false, false),
+		NewTextBlockObject(PlainTextType, "Delete", false, false),
+		nil,
+	).WithStyle(StyleDanger).WithDeny(NewTextBlockObject(PlainTextType, "Keep it", false, false))
+
+	marshalled, err := json.Marshal(confirmation)
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	expected := `{"title":{"type":"plain_text","text":"Delete the channel?"},"text":{"type":"plain_text","text":"Its messages will be lost."},"confirm":{"type":"plain_text","text":"Delete"},"deny":{"type":"plain_text","text":"Keep it"},"style":"danger"}`
+	assert.Equal(t, expected, string(marshalled))
+}
+
 func TestNewOptionBlockObject(t *testing.T) {
 
 	valTextObj := NewTextBlockObject("plain_text", "testText", false, false)
@@ -64,3 +81,13 @@ func TestNewOptionGroupBlockElement(t *testing.T) {
 	assert.Len(t, optGroup.Options, 1, "Options should contain one element")
 
 }
+
+func TestNewSanitizedTextBlockObject(t *testing.T) {
+	textObj := NewSanitizedTextBlockObject(MarkdownType, "*%s* asked for %d seats", "<!channel> Bob", 3)
+	assert.Equal(t, "*&lt;!channel&gt; Bob* asked for 3 seats", textObj.Text)
+	assert.True(t, textObj.Verbatim)
+
+	textObj = NewSanitizedTextBlockObject(PlainTextType, "Hello %s", "Bob\x00")
+	assert.Equal(t, "Hello Bob", textObj.Text)
+	assert.False(t, textObj.Verbatim)
+}
@@ -0,0 +1,580 @@
+package slack
+
+import (
+	"encoding/json"
+)
+
+// RichTextBlock defines a block of formatted text, such as the text of messages composed in slack clients.
+//
+// More Information: https://api.slack.com/reference/block-kit/blocks#rich_text
+type RichTextBlock struct {
+	Type     MessageBlockType  `json:"type"`
+	BlockID  string            `json:"block_id,omitempty"`
+	Elements []RichTextElement `json:"elements"`
+}
+
+// BlockType returns the type of the block
+func (b RichTextBlock) BlockType() MessageBlockType {
+	return b.Type
+}
+
+// NewRichTextBlock returns a new instance of a rich text block
+func NewRichTextBlock(blockID string, elements ...RichTextElement) *RichTextBlock {
+	return &RichTextBlock{
+		Type:     MBTRichText,
+		BlockID:  blockID,
+		Elements: elements,
+	}
+}
+
+// UnmarshalJSON implements the Unmarshaller interface for RichTextBlock, so that any JSON
+// unmarshalling is delegated and proper type determination can be made before unmarshal
+func (b *RichTextBlock) UnmarshalJSON(data []byte) error {
+	var raw struct {
+		Type     MessageBlockType  `json:"type"`
+		BlockID  string            `json:"block_id"`
+		Elements []json.RawMessage `json:"elements"`
+	}
+	if err := json.Unmarshal(data, &raw); err != nil {
+		return err
+	}
+
+	elements := make([]RichTextElement, 0, len(raw.Elements))
+	for _, r := range raw.Elements {
+		element, err := unmarshalRichTextElement(r)
+		if err != nil {
+			return err
+		}
+		elements = append(elements, element)
+	}
+
+	*b = RichTextBlock{
+		Type:     raw.Type,
+		BlockID:  raw.BlockID,
+		Elements: elements,
+	}
+	return nil
+}
+
+// RichTextElementType defines the type of the elements of a rich text block.
+type RichTextElementType string
+
+// RichTextElement defines an interface all the elements of a rich text block implement.
+type RichTextElement interface {
+	RichTextElementType() RichTextElementType
+}
+
+const (
+	RTESection      RichTextElementType = "rich_text_section"
+	RTEList         RichTextElementType = "rich_text_list"
+	RTEQuote        RichTextElementType = "rich_text_quote"
+	RTEPreformatted RichTextElementType = "rich_text_preformatted"
+)
+
+func unmarshalRichTextElement(data json.RawMessage) (RichTextElement, error) {
+	s := sumtype{}
+	if err := json.Unmarshal(data, &s); err != nil {
+		return nil, err
+	}
+
+	var element RichTextElement
+	switch RichTextElementType(s.TypeVal) {
+	case RTESection:
+		element = &RichTextSection{}
+	case RTEList:
+		element = &RichTextList{}
+	case RTEQuote:
+		element = &RichTextQuote{}
+	case RTEPreformatted:
+		element = &RichTextPreformatted{}
+	default:
+		return &RichTextUnknown{Type: RichTextElementType(s.TypeVal), Raw: data}, nil
+	}
+
+	if err := json.Unmarshal(data, element); err != nil {
+		return nil, err
+	}
+	return element, nil
+}
+
+// RichTextUnknown is an element of a rich text block whose type isn't known yet, it's re-encoded as received.
+type RichTextUnknown struct {
+	Type RichTextElementType
+	Raw  json.RawMessage
+}
+
+// RichTextElementType returns the type of the element
+func (u RichTextUnknown) RichTextElementType() RichTextElementType {
+	return u.Type
+}
+
+// MarshalJSON returns the element as it was received.
+func (u RichTextUnknown) MarshalJSON() ([]byte, error) {
+	if len(u.Raw) == 0 {
+		return json.Marshal(struct {
+			Type RichTextElementType `json:"type"`
+		}{u.Type})
+	}
+	return u.Raw, nil
+}
+
+// RichTextSection is a paragraph of a rich text block.
+type RichTextSection struct {
+	Type     RichTextElementType      `json:"type"`
+	Elements []RichTextSectionElement `json:"elements"`
+}
+
+// RichTextElementType returns the type of the element
+func (s RichTextSection) RichTextElementType() RichTextElementType {
+	return s.Type
+}
+
+// NewRichTextSection returns a new instance of a rich text section
+func NewRichTextSection(elements ...RichTextSectionElement) *RichTextSection {
+	return &RichTextSection{
+		Type:     RTESection,
+		Elements: elements,
+	}
+}
+
+// UnmarshalJSON implements the Unmarshaller interface for RichTextSection, so that any JSON
+// unmarshalling is delegated and proper type determination can be made before unmarshal
+func (s *RichTextSection) UnmarshalJSON(data []byte) error {
+	var raw struct {
+		Type     RichTextElementType `json:"type"`
+		Elements []json.RawMessage   `json:"elements"`
+	}
+	if err := json.Unmarshal(data, &raw); err != nil {
+		return err
+	}
+
+	elements, err := unmarshalRichTextSectionElements(raw.Elements)
+	if err != nil {
+		return err
+	}
+
+	*s = RichTextSection{
+		Type:     raw.Type,
+		Elements: elements,
+	}
+	return nil
+}
+
+// RichTextListElementType defines the style of a rich text list.
+type RichTextListElementType string
+
+const (
+	RTEListBullet  RichTextListElementType = "bullet"
+	RTEListOrdered RichTextListElementType = "ordered"
+)
+
+// RichTextList is a bulleted or numbered list, each item being a rich text section.
+// Indent is the nesting level of the list, and Offset the number of items preceding
+// it in an ordered list split by nested lists.
+type RichTextList struct {
+	Type     RichTextElementType     `json:"type"`
+	Elements []RichTextElement       `json:"elements"`
+	Style    RichTextListElementType `json:"style"`
+	Indent   int                     `json:"indent"`
+	Offset   int                     `json:"offset,omitempty"`
+	Border   int                     `json:"border,omitempty"`
+}
+
+// RichTextElementType returns the type of the element
+func (l RichTextList) RichTextElementType() RichTextElementType {
+	return l.Type
+}
+
+// NewRichTextList returns a new instance of a rich text list
+func NewRichTextList(style RichTextListElementType, indent int, elements ...RichTextElement) *RichTextList {
+	return &RichTextList{
+		Type:     RTEList,
+		Elements: elements,
+		Style:    style,
+		Indent:   indent,
+	}
+}
+
+// UnmarshalJSON implements the Unmarshaller interface for RichTextList, so that any JSON
+// unmarshalling is delegated and proper type determination can be made before unmarshal
+func (l *RichTextList) UnmarshalJSON(data []byte) error {
+	var raw struct {
+		Type     RichTextElementType     `json:"type"`
+		Elements []json.RawMessage       `json:"elements"`
+		Style    RichTextListElementType `json:"style"`
+		Indent   int                     `json:"indent"`
+		Offset   int                     `json:"offset"`
+		Border   int                     `json:"border"`
+	}
+	if err := json.Unmarshal(data, &raw); err != nil {
+		return err
+	}
+
+	elements := make([]RichTextElement, 0, len(raw.Elements))
+	for _, r := range raw.Elements {
+		element, err := unmarshalRichTextElement(r)
+		if err != nil {
+			return err
+		}
+		elements = append(elements, element)
+	}
+
+	*l = RichTextList{
+		Type:     raw.Type,
+		Elements: elements,
+		Style:    raw.Style,
+		Indent:   raw.Indent,
+		Offset:   raw.Offset,
+		Border:   raw.Border,
+	}
+	return nil
+}
+
+// RichTextQuote is a quoted paragraph, Border is set when the quote is displayed with a border.
+type RichTextQuote struct {
+	Type     RichTextElementType      `json:"type"`
+	Elements []RichTextSectionElement `json:"elements"`
+	Border   int                      `json:"border,omitempty"`
+}
+
+// RichTextElementType returns the type of the element
+func (q RichTextQuote) RichTextElementType() RichTextElementType {
+	return q.Type
+}
+
+// NewRichTextQuote returns a new instance of a rich text quote
+func NewRichTextQuote(elements ...RichTextSectionElement) *RichTextQuote {
+	return &RichTextQuote{
+		Type:     RTEQuote,
+		Elements: elements,
+	}
+}
+
+// UnmarshalJSON implements the Unmarshaller interface for RichTextQuote, so that any JSON
+// unmarshalling is delegated and proper type determination can be made before unmarshal
+func (q *RichTextQuote) UnmarshalJSON(data []byte) error {
+	var raw struct {
+		Type     RichTextElementType `json:"type"`
+		Elements []json.RawMessage   `json:"elements"`
+		Border   int                 `json:"border"`
+	}
+	if err := json.Unmarshal(data, &raw); err != nil {
+		return err
+	}
+
+	elements, err := unmarshalRichTextSectionElements(raw.Elements)
+	if err != nil {
+		return err
+	}
+
+	*q = RichTextQuote{
+		Type:     raw.Type,
+		Elements: elements,
+		Border:   raw.Border,
+	}
+	return nil
+}
+
+// RichTextPreformatted is a code block, Border is set when the block is displayed with a border.
+type RichTextPreformatted struct {
+	Type     RichTextElementType      `json:"type"`
+	Elements []RichTextSectionElement `json:"elements"`
+	Border   int                      `json:"border,omitempty"`
+}
+
+// RichTextElementType returns the type of the element
+func (p RichTextPreformatted) RichTextElementType() RichTextElementType {
+	return p.Type
+}
+
+// NewRichTextPreformatted returns a new instance of a preformatted rich text element
+func NewRichTextPreformatted(elements ...RichTextSectionElement) *RichTextPreformatted {
+	return &RichTextPreformatted{
+		Type:     RTEPreformatted,
+		Elements: elements,
+	}
+}
+
+// UnmarshalJSON implements the Unmarshaller interface for RichTextPreformatted, so that any JSON
+// unmarshalling is delegated and proper type determination can be made before unmarshal
+func (p *RichTextPreformatted) UnmarshalJSON(data []byte) error {
+	var raw struct {
+		Type     RichTextElementType `json:"type"`
+		Elements []json.RawMessage   `json:"elements"`
+		Border   int                 `json:"border"`
+	}
+	if err := json.Unmarshal(data, &raw); err != nil {
+		return err
+	}
+
+	elements, err := unmarshalRichTextSectionElements(raw.Elements)
+	if err != nil {
+		return err
+	}
+
+	*p = RichTextPreformatted{
+		Type:     raw.Type,
+		Elements: elements,
+		Border:   raw.Border,
+	}
+	return nil
+}
+
+// RichTextSectionElementType defines the type of the elements of a rich text section.
+type RichTextSectionElementType string
+
+// RichTextSectionElement defines an interface all the elements of a rich text section implement.
+type RichTextSectionElement interface {
+	RichTextSectionElementType() RichTextSectionElementType
+}
+
+const (
+	RTSEText      RichTextSectionElementType = "text"
+	RTSEChannel   RichTextSectionElementType = "channel"
+	RTSEUser      RichTextSectionElementType = "user"
+	RTSEEmoji     RichTextSectionElementType = "emoji"
+	RTSELink      RichTextSectionElementType = "link"
+	RTSETeam      RichTextSectionElementType = "team"
+	RTSEUserGroup RichTextSectionElementType = "usergroup"
+	RTSEDate      RichTextSectionElementType = "date"
+	RTSEBroadcast RichTextSectionElementType = "broadcast"
+	RTSEColor     RichTextSectionElementType = "color"
+)
+
+func unmarshalRichTextSectionElements(raw []json.RawMessage) ([]RichTextSectionElement, error) {
+	elements := make([]RichTextSectionElement, 0, len(raw))
+	for _, r := range raw {
+		s := sumtype{}
+		if err := json.Unmarshal(r, &s); err != nil {
+			return nil, err
+		}
+
+		var element RichTextSectionElement
+		switch RichTextSectionElementType(s.TypeVal) {
+		case RTSEText:
+			element = &RichTextSectionTextElement{}
+		case RTSEChannel:
+			element = &RichTextSectionChannelElement{}
+		case RTSEUser:
+			element = &RichTextSectionUserElement{}
+		case RTSEEmoji:
+			element = &RichTextSectionEmojiElement{}
+		case RTSELink:
+			element = &RichTextSectionLinkElement{}
+		case RTSETeam:
+			element = &RichTextSectionTeamElement{}
+		case RTSEUserGroup:
+			element = &RichTextSectionUserGroupElement{}
+		case RTSEDate:
+			element = &RichTextSectionDateElement{}
+		case RTSEBroadcast:
+			element = &RichTextSectionBroadcastElement{}
+		case RTSEColor:
+			element = &RichTextSectionColorElement{}
+		default:
+			elements = append(elements, &RichTextSectionUnknownElement{Type: RichTextSectionElementType(s.TypeVal), Raw: r})
+			continue
+		}
+
+		if err := json.Unmarshal(r, element); err != nil {
+			return nil, err
+		}
+		elements = append(elements, element)
+	}
+
+	return elements, nil
+}
+
+// RichTextSectionTextStyle is the formatting applied to an element of a rich text section.
+type RichTextSectionTextStyle struct {
+	Bold   bool `json:"bold,omitempty"`
+	Italic bool `json:"italic,omitempty"`
+	Strike bool `json:"strike,omitempty"`
+	Code   bool `json:"code,omitempty"`
+}
+
+// RichTextSectionTextElement is a run of text.
+type RichTextSectionTextElement struct {
+	Type  RichTextSectionElementType `json:"type"`
+	Text  string                     `json:"text"`
+	Style *RichTextSectionTextStyle  `json:"style,omitempty"`
+}
+
+// RichTextSectionElementType returns the type of the element
+func (r RichTextSectionTextElement) RichTextSectionElementType() RichTextSectionElementType {
+	return r.Type
+}
+
+// NewRichTextSectionTextElement returns a new instance of a text element, style is optional.
+func NewRichTextSectionTextElement(text string, style *RichTextSectionTextStyle) *RichTextSectionTextElement {
+	return &RichTextSectionTextElement{
+		Type:  RTSEText,
+		Text:  text,
+		Style: style,
+	}
+}
+
+// RichTextSectionChannelElement is a reference to a channel.
+type RichTextSectionChannelElement struct {
+	Type      RichTextSectionElementType `json:"type"`
+	ChannelID string                     `json:"channel_id"`
+	Style     *RichTextSectionTextStyle  `json:"style,omitempty"`
+}
+
+// RichTextSectionElementType returns the type of the element
+func (r RichTextSectionChannelElement) RichTextSectionElementType() RichTextSectionElementType {
+	return r.Type
+}
+
+// NewRichTextSectionChannelElement returns a new instance of a channel element
+func NewRichTextSectionChannelElement(channelID string, style *RichTextSectionTextStyle) *RichTextSectionChannelElement {
+	return &RichTextSectionChannelElement{
+		Type:      RTSEChannel,
+		ChannelID: channelID,
+		Style:     style,
+	}
+}
+
+// RichTextSectionUserElement is a mention of a user.
+type RichTextSectionUserElement struct {
+	Type   RichTextSectionElementType `json:"type"`
+	UserID string                     `json:"user_id"`
+	Style  *RichTextSectionTextStyle  `json:"style,omitempty"`
+}
+
+// RichTextSectionElementType returns the type of the element
+func (r RichTextSectionUserElement) RichTextSectionElementType() RichTextSectionElementType {
+	return r.Type
+}
+
+// NewRichTextSectionUserElement returns a new instance of a user element
+func NewRichTextSectionUserElement(userID string, style *RichTextSectionTextStyle) *RichTextSectionUserElement {
+	return &RichTextSectionUserElement{
+		Type:   RTSEUser,
+		UserID: userID,
+		Style:  style,
+	}
+}
+
+// RichTextSectionEmojiElement is an emoji.
+type RichTextSectionEmojiElement struct {
+	Type     RichTextSectionElementType `json:"type"`
+	Name     string                     `json:"name"`
+	SkinTone int                        `json:"skin_tone,omitempty"`
+	Style    *RichTextSectionTextStyle  `json:"style,omitempty"`
+}
+
+// RichTextSectionElementType returns the type of the element
+func (r RichTextSectionEmojiElement) RichTextSectionElementType() RichTextSectionElementType {
+	return r.Type
+}
+
+// NewRichTextSectionEmojiElement returns a new instance of an emoji element
+func NewRichTextSectionEmojiElement(name string, skinTone int, style *RichTextSectionTextStyle) *RichTextSectionEmojiElement {
+	return &RichTextSectionEmojiElement{
+		Type:     RTSEEmoji,
+		Name:     name,
+		SkinTone: skinTone,
+		Style:    style,
+	}
+}
+
+// RichTextSectionLinkElement is a link, Text is displayed instead of the URL when set.
+type RichTextSectionLinkElement struct {
+	Type  RichTextSectionElementType `json:"type"`
+	URL   string                     `json:"url"`
+	Text  string                     `json:"text,omitempty"`
+	Style *RichTextSectionTextStyle  `json:"style,omitempty"`
+}
+
+// RichTextSectionElementType returns the type of the element
+func (r RichTextSectionLinkElement) RichTextSectionElementType() RichTextSectionElementType {
+	return r.Type
+}
+
+// NewRichTextSectionLinkElement returns a new instance of a link element
+func NewRichTextSectionLinkElement(url, text string, style *RichTextSectionTextStyle) *RichTextSectionLinkElement {
+	return &RichTextSectionLinkElement{
+		Type:  RTSELink,
+		URL:   url,
+		Text:  text,
+		Style: style,
+	}
+}
+
+// RichTextSectionTeamElement is a reference to a team.
+type RichTextSectionTeamElement struct {
+	Type   RichTextSectionElementType `json:"type"`
+	TeamID string                     `json:"team_id"`
+	Style  *RichTextSectionTextStyle  `json:"style,omitempty"`
+}
+
+// RichTextSectionElementType returns the type of the element
+func (r RichTextSectionTeamElement) RichTextSectionElementType() RichTextSectionElementType {
+	return r.Type
+}
+
+// RichTextSectionUserGroupElement is a mention of a user group.
+type RichTextSectionUserGroupElement struct {
+	Type        RichTextSectionElementType `json:"type"`
+	UsergroupID string                     `json:"usergroup_id"`
+}
+
+// RichTextSectionElementType returns the type of the element
+func (r RichTextSectionUserGroupElement) RichTextSectionElementType() RichTextSectionElementType {
+	return r.Type
+}
+
+// RichTextSectionDateElement is a date displayed in the timezone of the reader.
+type RichTextSectionDateElement struct {
+	Type      RichTextSectionElementType `json:"type"`
+	Timestamp JSONTime                   `json:"timestamp"`
+}
+
+// RichTextSectionElementType returns the type of the element
+func (r RichTextSectionDateElement) RichTextSectionElementType() RichTextSectionElementType {
+	return r.Type
+}
+
+// RichTextSectionBroadcastElement is a broadcast mention, Range is here, channel or everyone.
+type RichTextSectionBroadcastElement struct {
+	Type  RichTextSectionElementType `json:"type"`
+	Range string                     `json:"range"`
+}
+
+// RichTextSectionElementType returns the type of the element
+func (r RichTextSectionBroadcastElement) RichTextSectionElementType() RichTextSectionElementType {
+	return r.Type
+}
+
+// RichTextSectionColorElement is a color, such as #FF0000.
+type RichTextSectionColorElement struct {
+	Type  RichTextSectionElementType `json:"type"`
+	Value string                     `json:"value"`
+}
+
+// RichTextSectionElementType returns the type of the element
+func (r RichTextSectionColorElement) RichTextSectionElementType() RichTextSectionElementType {
+	return r.Type
+}
+
+// RichTextSectionUnknownElement is an element of a rich text section whose type isn't known yet, it's re-encoded as received.
+type RichTextSectionUnknownElement struct {
+	Type RichTextSectionElementType
+	Raw  json.RawMessage
+}
+
+// RichTextSectionElementType returns the type of the element
+func (r RichTextSectionUnknownElement) RichTextSectionElementType() RichTextSectionElementType {
+	return r.Type
+}
+
+// MarshalJSON returns the element as it was received.
+func (r RichTextSectionUnknownElement) MarshalJSON() ([]byte, error) {
+	if len(r.Raw) == 0 {
+		return json.Marshal(struct {
+			Type RichTextSectionElementType `json:"type"`
+		}{r.Type})
+	}
+	return r.Raw, nil
+}
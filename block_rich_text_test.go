@@ -0,0 +1,132 @@
+package slack
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNewRichTextBlock(t *testing.T) {
+	section := NewRichTextSection(
+		NewRichTextSectionTextElement("Hello ", nil),
+		NewRichTextSectionUserElement("U123", &RichTextSectionTextStyle{Bold: true}),
+	)
+
+	richTextBlock := NewRichTextBlock("test", section)
+	assert.Equal(t, string(richTextBlock.Type), "rich_text")
+	assert.Equal(t, richTextBlock.BlockID, "test")
+	assert.Len(t, richTextBlock.Elements, 1)
+}
+
+func TestRichTextBlockUnmarshal(t *testing.T) {
+	payload := `[{"type":"rich_text","block_id":"FaYCD","elements":[
+		{"type":"rich_text_section","elements":[
+			{"type":"text","text":"Deploy "},
+			{"type":"text","text":"done","style":{"bold":true}},
+			{"type":"user","user_id":"U123"},
+			{"type":"channel","channel_id":"C123"},
+			{"type":"emoji","name":"tada","skin_tone":2},
+			{"type":"link","url":"https://example.com","text":"logs"},
+			{"type":"broadcast","range":"here"},
+			{"type":"sparkle","intensity":11}
+		]},
+		{"type":"rich_text_future","elements":[]}
+	]}]`
+
+	var blocks Blocks
+	if err := json.Unmarshal([]byte(payload), &blocks); err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	block, ok := blocks.BlockSet[0].(*RichTextBlock)
+	if !assert.True(t, ok, "expected a *RichTextBlock, got %T", blocks.BlockSet[0]) {
+		return
+	}
+	if !assert.Len(t, block.Elements, 2) {
+		return
+	}
+
+	section, ok := block.Elements[0].(*RichTextSection)
+	if !assert.True(t, ok, "expected a *RichTextSection, got %T", block.Elements[0]) {
+		return
+	}
+	assert.Equal(t, &RichTextSectionTextElement{Type: RTSEText, Text: "done", Style: &RichTextSectionTextStyle{Bold: true}}, section.Elements[1])
+	assert.Equal(t, &RichTextSectionUserElement{Type: RTSEUser, UserID: "U123"}, section.Elements[2])
+	assert.Equal(t, &RichTextSectionEmojiElement{Type: RTSEEmoji, Name: "tada", SkinTone: 2}, section.Elements[4])
+	assert.Equal(t, &RichTextSectionBroadcastElement{Type: RTSEBroadcast, Range: "here"}, section.Elements[6])
+	assert.IsType(t, &RichTextSectionUnknownElement{}, section.Elements[7])
+	assert.IsType(t, &RichTextUnknown{}, block.Elements[1])
+
+	marshalled, err := json.Marshal(blocks)
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	assert.JSONEq(t, payload, string(marshalled))
+}
+
+func TestRichTextListQuotePreformattedUnmarshal(t *testing.T) {
+	payload := `[{"type":"rich_text","block_id":"x1","elements":[
+		{"type":"rich_text_list","style":"ordered","indent":1,"offset":2,"border":1,"elements":[
+			{"type":"rich_text_section","elements":[{"type":"text","text":"step one"}]},
+			{"type":"rich_text_section","elements":[{"type":"text","text":"step two","style":{"italic":true}}]}
+		]},
+		{"type":"rich_text_quote","border":1,"elements":[{"type":"text","text":"quoted"},{"type":"user","user_id":"U123"}]},
+		{"type":"rich_text_preformatted","elements":[{"type":"text","text":"go test ./..."}]}
+	]}]`
+
+	var blocks Blocks
+	if err := json.Unmarshal([]byte(payload), &blocks); err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	block := blocks.BlockSet[0].(*RichTextBlock)
+	if !assert.Len(t, block.Elements, 3) {
+		return
+	}
+
+	list, ok := block.Elements[0].(*RichTextList)
+	if !assert.True(t, ok, "expected a *RichTextList, got %T", block.Elements[0]) {
+		return
+	}
+	assert.Equal(t, RTEListOrdered, list.Style)
+	assert.Equal(t, 1, list.Indent)
+	assert.Equal(t, 2, list.Offset)
+	assert.Equal(t, 1, list.Border)
+	assert.Equal(t, NewRichTextSection(NewRichTextSectionTextElement("step one", nil)), list.Elements[0])
+
+	quote, ok := block.Elements[1].(*RichTextQuote)
+	if !assert.True(t, ok, "expected a *RichTextQuote, got %T", block.Elements[1]) {
+		return
+	}
+	assert.Equal(t, 1, quote.Border)
+	assert.Equal(t, &RichTextSectionUserElement{Type: RTSEUser, UserID: "U123"}, quote.Elements[1])
+
+	preformatted, ok := block.Elements[2].(*RichTextPreformatted)
+	if !assert.True(t, ok, "expected a *RichTextPreformatted, got %T", block.Elements[2]) {
+		return
+	}
+	assert.Equal(t, NewRichTextSectionTextElement("go test ./...", nil), preformatted.Elements[0])
+
+	marshalled, err := json.Marshal(blocks)
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	assert.JSONEq(t, payload, string(marshalled))
+}
+
+func TestNewRichTextList(t *testing.T) {
+	list := NewRichTextList(RTEListBullet, 0,
+		NewRichTextSection(NewRichTextSectionTextElement("first", nil)),
+		NewRichTextSection(NewRichTextSectionTextElement("second", nil)),
+	)
+
+	marshalled, err := json.Marshal(list)
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	assert.JSONEq(t, `{"type":"rich_text_list","style":"bullet","indent":0,"elements":[
+		{"type":"rich_text_section","elements":[{"type":"text","text":"first"}]},
+		{"type":"rich_text_section","elements":[{"type":"text","text":"second"}]}
+	]}`, string(marshalled))
+}
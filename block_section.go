@@ -8,7 +8,7 @@ type SectionBlock struct {
 	Text      *TextBlockObject   `json:"text,omitempty"`
 	BlockID   string             `json:"block_id,omitempty"`
 	Fields    []*TextBlockObject `json:"fields,omitempty"`
-	Accessory *Accessory         `json:"accessory,omitempty"`
+	Accessory SectionAccessory   `json:"accessory,omitempty"`
 }
 
 // BlockType returns the type of the block
@@ -26,7 +26,7 @@ func SectionBlockOptionBlockID(blockID string) SectionBlockOption {
 }
 
 // NewSectionBlock returns a new instance of a section block to be rendered
-func NewSectionBlock(textObj *TextBlockObject, fields []*TextBlockObject, accessory *Accessory, options ...SectionBlockOption) *SectionBlock {
+func NewSectionBlock(textObj *TextBlockObject, fields []*TextBlockObject, accessory SectionAccessory, options ...SectionBlockOption) *SectionBlock {
 	block := SectionBlock{
 		Type:      MBTSection,
 		Text:      textObj,
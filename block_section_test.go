@@ -1,6 +1,7 @@
 package slack
 
 import (
+	"encoding/json"
 	"testing"
 
 	"github.com/stretchr/testify/assert"
@@ -23,7 +24,7 @@ func TestNewSectionBlock(t *testing.T) {
 func TestNewBlockSectionContainsAddedTextBlockAndAccessory(t *testing.T) {
 	textBlockObject := NewTextBlockObject("mrkdwn", "You have a new test: *Hi there* :wave:", true, false)
 	conflictImage := NewImageBlockElement("https://api.slack.com/img/blocks/bkb_template_images/notificationsWarningIcon.png", "notifications warning icon")
-	sectionBlock := NewSectionBlock(textBlockObject, nil, NewAccessory(conflictImage))
+	sectionBlock := NewSectionBlock(textBlockObject, nil, conflictImage)
 
 	assert.Equal(t, sectionBlock.BlockType(), MBTSection)
 	assert.Equal(t, len(sectionBlock.BlockID), 0)
@@ -32,5 +33,44 @@ func TestNewBlockSectionContainsAddedTextBlockAndAccessory(t *testing.T) {
 	assert.Equal(t, textBlockInSection.Type, textBlockObject.Type)
 	assert.True(t, textBlockInSection.Emoji)
 	assert.False(t, textBlockInSection.Verbatim)
-	assert.Equal(t, sectionBlock.Accessory.ImageElement, conflictImage)
+	assert.Equal(t, sectionBlock.Accessory, conflictImage)
+}
+
+func TestBlockSectionAccessoryJSON(t *testing.T) {
+	textBlockObject := NewTextBlockObject("mrkdwn", "Pick a date", false, false)
+	datePicker := NewDatePickerBlockElement("deadline")
+
+	for _, accessory := range []SectionAccessory{datePicker, NewAccessory(datePicker)} {
+		encoded, err := json.Marshal(NewSectionBlock(textBlockObject, nil, accessory))
+		assert.Nil(t, err)
+		assert.Contains(t, string(encoded), `"accessory":{"type":"datepicker","action_id":"deadline"}`)
+
+		var decoded SectionBlock
+		assert.Nil(t, json.Unmarshal(encoded, &decoded))
+		assert.Equal(t, datePicker, decoded.Accessory)
+	}
+
+	var decoded SectionBlock
+	assert.Nil(t, json.Unmarshal([]byte(`{"type":"section","text":{"type":"mrkdwn","text":"hi"}}`), &decoded))
+	assert.Nil(t, decoded.Accessory)
+	assert.Equal(t, "hi", decoded.Text.Text)
+}
+
+func TestAccessoryElementType(t *testing.T) {
+	assert.Equal(t, MessageElementType(""), (&Accessory{}).ElementType())
+
+	var unknown Accessory
+	if err := json.Unmarshal([]byte(`{"type":"future_picker","action_id":"when"}`), &unknown); err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	assert.Equal(t, MessageElementType("future_picker"), unknown.ElementType())
+
+	var input Accessory
+	if err := json.Unmarshal([]byte(`{"type":"plain_text_input","action_id":"note"}`), &input); err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	if assert.NotNil(t, input.PlainTextInputElement) {
+		assert.Equal(t, "note", input.PlainTextInputElement.ActionID)
+	}
+	assert.Equal(t, METPlainTextInput, input.ElementType())
 }
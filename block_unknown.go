@@ -1,10 +1,14 @@
 package slack
 
+import "encoding/json"
+
 // UnknownBlock represents a block type that is not yet known. This block type exists to prevent Slack from introducing
 // new and unknown block types that break this library.
 type UnknownBlock struct {
 	Type    MessageBlockType `json:"type"`
 	BlockID string           `json:"block_id,omitempty"`
+
+	raw json.RawMessage
 }
 
 // BlockType returns the type of the block
@@ -0,0 +1,41 @@
+package slack
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNewVideoBlock(t *testing.T) {
+	title := NewTextBlockObject("plain_text", "Release demo", false, false)
+
+	videoBlock := NewVideoBlock("https://example.com/embed/1", "https://example.com/thumb.png", "demo", "test", title)
+	assert.Equal(t, string(videoBlock.Type), "video")
+	assert.Equal(t, videoBlock.BlockID, "test")
+	assert.Equal(t, videoBlock.VideoURL, "https://example.com/embed/1")
+	assert.Equal(t, videoBlock.ThumbnailURL, "https://example.com/thumb.png")
+	assert.Equal(t, videoBlock.Title, title)
+}
+
+func TestVideoBlockUnmarshal(t *testing.T) {
+	payload := `[{"type":"video","block_id":"demo","video_url":"https://example.com/embed/1","thumbnail_url":"https://example.com/thumb.png","alt_text":"demo","title":{"type":"plain_text","text":"Release demo"},"description":{"type":"plain_text","text":"What's new"},"provider_name":"Example","author_name":"Alice"}]`
+
+	var blocks Blocks
+	if err := json.Unmarshal([]byte(payload), &blocks); err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	video, ok := blocks.BlockSet[0].(*VideoBlock)
+	if !assert.True(t, ok, "expected a *VideoBlock, got %T", blocks.BlockSet[0]) {
+		return
+	}
+	assert.Equal(t, "Example", video.ProviderName)
+	assert.Equal(t, "What's new", video.Description.Text)
+
+	marshalled, err := json.Marshal(blocks)
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	assert.JSONEq(t, payload, string(marshalled))
+}
@@ -0,0 +1,324 @@
+package slack
+
+import (
+	"context"
+	"regexp"
+	"strings"
+	"time"
+)
+
+// ConversationMatcher selects conversations for a bulk operation.
+type ConversationMatcher func(Channel) bool
+
+// MatchNamePrefix matches conversations whose name starts with prefix.
+func MatchNamePrefix(prefix string) ConversationMatcher {
+	return func(c Channel) bool {
+		return strings.HasPrefix(c.Name, prefix)
+	}
+}
+
+// MatchNameRegexp matches conversations whose name matches re.
+func MatchNameRegexp(re *regexp.Regexp) ConversationMatcher {
+	return func(c Channel) bool {
+		return re.MatchString(c.Name)
+	}
+}
+
+// FindConversations pages through conversations.list and returns the conversations accepted by match.
+// Rate limited requests are retried once the requested delay has passed.
+func (api *Client) FindConversations(params GetConversationsParameters, match ConversationMatcher) ([]Channel, error) {
+	return api.FindConversationsContext(context.Background(), params, match)
+}
+
+// FindConversationsContext pages through conversations.list and returns the conversations accepted by match with a custom context.
+func (api *Client) FindConversationsContext(ctx context.Context, params GetConversationsParameters, match ConversationMatcher) ([]Channel, error) {
+	var matches []Channel
+	for {
+		channels, cursor, err := api.GetConversationsContext(ctx, &params)
+		if rateLimitedError, ok := err.(*RateLimitedError); ok {
+			select {
+			case <-ctx.Done():
+				return nil, ctx.Err()
+			case <-api.currentClock().After(rateLimitedError.RetryAfter):
+				continue
+			}
+		}
+		if err != nil {
+			return nil, err
+		}
+
+		for _, channel := range channels {
+			if match(channel) {
+				matches = append(matches, channel)
+			}
+		}
+
+		if cursor == "" {
+			return matches, nil
+		}
+		params.Cursor = cursor
+	}
+}
+
+// BulkOperation is applied to each conversation of a bulk run.
+type BulkOperation func(ctx context.Context, api *Client, channel Channel) error
+
+// BulkInviteUsers invites the users to each conversation.
+func BulkInviteUsers(users ...string) BulkOperation {
+	return func(ctx context.Context, api *Client, channel Channel) error {
+		_, err := api.InviteUsersToConversationContext(ctx, channel.ID, users...)
+		return err
+	}
+}
+
+// BulkPostMessage posts a message to each conversation.
+func BulkPostMessage(options ...MsgOption) BulkOperation {
+	return func(ctx context.Context, api *Client, channel Channel) error {
+		_, _, err := api.PostMessageContext(ctx, channel.ID, options...)
+		return err
+	}
+}
+
+// BulkArchive archives each conversation.
+func BulkArchive() BulkOperation {
+	return func(ctx context.Context, api *Client, channel Channel) error {
+		return api.ArchiveConversationContext(ctx, channel.ID)
+	}
+}
+
+// BulkParameters controls a bulk run.
+type BulkParameters struct {
+	// DryRun reports the conversations the operation would be applied to without applying it.
+	DryRun bool
+	// Interval is the minimum delay between two operations, use it to stay within the rate limit
+	// of the methods called by the operation.
+	Interval time.Duration
+	// StopOnError stops the run at the first failed operation.
+	StopOnError bool
+}
+
+// BulkResult is the outcome of a bulk operation on a single conversation.
+type BulkResult struct {
+	Channel Channel
+	// Applied is false for dry runs and for conversations skipped after an error.
+	Applied bool
+	Err     error
+}
+
+// Bulk applies op to each of the channels, one at a time. Rate limited operations are retried
+// once the requested delay has passed. A result is returned for every channel.
+func (api *Client) Bulk(channels []Channel, op BulkOperation, params BulkParameters) []BulkResult {
+	return api.BulkContext(context.Background(), channels, op, params)
+}
+
+// BulkContext applies op to each of the channels, one at a time, with a custom context.
+func (api *Client) BulkContext(ctx context.Context, channels []Channel, op BulkOperation, params BulkParameters) []BulkResult {
+	results := make([]BulkResult, 0, len(channels))
+	stopped := false
+	for i, channel := range channels {
+		result := BulkResult{Channel: channel}
+		if params.DryRun || stopped {
+			results = append(results, result)
+			continue
+		}
+
+		if i > 0 && params.Interval > 0 {
+			select {
+			case <-ctx.Done():
+			case <-api.currentClock().After(params.Interval):
+			}
+		}
+
+		result.Err = api.bulkApply(ctx, op, channel)
+		result.Applied = result.Err == nil
+		results = append(results, result)
+
+		if result.Err != nil && (params.StopOnError || ctx.Err() != nil) {
+			stopped = true
+		}
+	}
+
+	return results
+}
+
+func (api *Client) bulkApply(ctx context.Context, op BulkOperation, channel Channel) error {
+	return retryRateLimited(ctx, api.currentClock(), func() error {
+		return op(ctx, api, channel)
+	})
+}
+
+// retryRateLimited calls fn until it isn't rate limited anymore, waiting for the requested delay in between.
+func retryRateLimited(ctx context.Context, clock Clock, fn func() error) error {
+	for {
+		if err := ctx.Err(); err != nil {
+			return err
+		}
+
+		err := fn()
+		rateLimitedError, ok := err.(*RateLimitedError)
+		if !ok {
+			return err
+		}
+
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-clock.After(rateLimitedError.RetryAfter):
+		}
+	}
+}
+
+// DeleteMessagesParameters controls a DeleteMessages run.
+type DeleteMessagesParameters struct {
+	// Interval is the minimum delay between two deletions, chat.delete is a tier 3 method.
+	Interval time.Duration
+	// StopOnError stops the run at the first failed deletion, messages which were already
+	// deleted aren't errors.
+	StopOnError bool
+}
+
+// DeleteResult is the outcome of the deletion of a single message.
+type DeleteResult struct {
+	Message ItemRef
+	// Deleted is false for messages which were already deleted, skipped after an error, or failed.
+	Deleted bool
+	// NotFound is set when slack responded with message_not_found.
+	NotFound bool
+	Err      error
+}
+
+// DeleteMessages deletes the messages, one at a time, e.g. to clean up after a moderation action
+// or to apply a retention policy. Rate limited deletions are retried once the requested delay has
+// passed, and messages which were already deleted are skipped. A result is returned for every message.
+func (api *Client) DeleteMessages(messages []ItemRef, params DeleteMessagesParameters) []DeleteResult {
+	return api.DeleteMessagesContext(context.Background(), messages, params)
+}
+
+// DeleteMessagesContext deletes the messages, one at a time, with a custom context.
+func (api *Client) DeleteMessagesContext(ctx context.Context, messages []ItemRef, params DeleteMessagesParameters) []DeleteResult {
+	results := make([]DeleteResult, 0, len(messages))
+	stopped := false
+	for i, message := range messages {
+		result := DeleteResult{Message: message}
+		if stopped {
+			results = append(results, result)
+			continue
+		}
+
+		if i > 0 && params.Interval > 0 {
+			select {
+			case <-ctx.Done():
+			case <-api.currentClock().After(params.Interval):
+			}
+		}
+
+		err := retryRateLimited(ctx, api.currentClock(), func() error {
+			_, _, err := api.DeleteMessageContext(ctx, message.Channel, message.Timestamp)
+			return err
+		})
+		switch {
+		case err == nil:
+			result.Deleted = true
+		case isSlackError(err, "message_not_found"):
+			result.NotFound = true
+		default:
+			result.Err = err
+		}
+		results = append(results, result)
+
+		if result.Err != nil && (params.StopOnError || ctx.Err() != nil) {
+			stopped = true
+		}
+	}
+
+	return results
+}
+
+// RemovalResult is the outcome of the removal of a user from a conversation.
+type RemovalResult string
+
+const (
+	RemovalRemoved      RemovalResult = "removed"
+	RemovalNotInChannel RemovalResult = "not_in_channel"
+	RemovalFailed       RemovalResult = "failed"
+	RemovalSkipped      RemovalResult = "skipped"
+)
+
+// RemovalRecord is the outcome of the removal of a single user.
+type RemovalRecord struct {
+	User   string        `json:"user"`
+	Result RemovalResult `json:"result"`
+	Error  string        `json:"error,omitempty"`
+}
+
+// RemovalAudit records the removal of users from a conversation, it's meant to be logged
+// as is, e.g. encoded as JSON, by offboarding automation.
+type RemovalAudit struct {
+	Channel    string          `json:"channel"`
+	StartedAt  time.Time       `json:"started_at"`
+	FinishedAt time.Time       `json:"finished_at"`
+	Users      []RemovalRecord `json:"users"`
+}
+
+// RemoveUsersParameters controls a RemoveUsersFromConversation run.
+type RemoveUsersParameters struct {
+	// Interval is the minimum delay between two removals, conversations.kick is a tier 3 method.
+	Interval time.Duration
+	// StopOnError skips the remaining users after the first failed removal, users who
+	// weren't in the conversation aren't errors.
+	StopOnError bool
+}
+
+// RemoveUsersFromConversation removes the users from the conversation, one at a time. Rate limited
+// removals are retried once the requested delay has passed, and users who weren't members are
+// recorded as such. A record is returned for every user.
+func (api *Client) RemoveUsersFromConversation(channelID string, userIDs []string, params RemoveUsersParameters) RemovalAudit {
+	return api.RemoveUsersFromConversationContext(context.Background(), channelID, userIDs, params)
+}
+
+// RemoveUsersFromConversationContext removes the users from the conversation, one at a time, with a custom context.
+func (api *Client) RemoveUsersFromConversationContext(ctx context.Context, channelID string, userIDs []string, params RemoveUsersParameters) RemovalAudit {
+	clock := api.currentClock()
+	audit := RemovalAudit{
+		Channel:   channelID,
+		StartedAt: clock.Now(),
+		Users:     make([]RemovalRecord, 0, len(userIDs)),
+	}
+
+	stopped := false
+	for i, user := range userIDs {
+		record := RemovalRecord{User: user, Result: RemovalSkipped}
+		if stopped {
+			audit.Users = append(audit.Users, record)
+			continue
+		}
+
+		if i > 0 && params.Interval > 0 {
+			select {
+			case <-ctx.Done():
+			case <-clock.After(params.Interval):
+			}
+		}
+
+		err := retryRateLimited(ctx, clock, func() error {
+			return api.KickUserFromConversationContext(ctx, channelID, user)
+		})
+		switch {
+		case err == nil:
+			record.Result = RemovalRemoved
+		case isSlackError(err, "not_in_channel"):
+			record.Result = RemovalNotInChannel
+		default:
+			record.Result = RemovalFailed
+			record.Error = err.Error()
+		}
+		audit.Users = append(audit.Users, record)
+
+		if record.Result == RemovalFailed && (params.StopOnError || ctx.Err() != nil) {
+			stopped = true
+		}
+	}
+
+	audit.FinishedAt = clock.Now()
+	return audit
+}
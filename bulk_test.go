@@ -0,0 +1,227 @@
+package slack
+
+import (
+	"net/http"
+	"reflect"
+	"regexp"
+	"sync"
+	"testing"
+)
+
+func bulkConversationsHandler(rw http.ResponseWriter, r *http.Request) {
+	rw.Header().Set("Content-Type", "application/json")
+	if r.FormValue("cursor") == "" {
+		rw.Write([]byte(`{"ok": true, "channels": [
+			{"id": "C1", "name": "team-alpha"},
+			{"id": "C2", "name": "random"}
+		], "response_metadata": {"next_cursor": "page2"}}`))
+		return
+	}
+	rw.Write([]byte(`{"ok": true, "channels": [
+		{"id": "C3", "name": "team-beta"},
+		{"id": "C4", "name": "ext-team-gamma"}
+	], "response_metadata": {"next_cursor": ""}}`))
+}
+
+func TestFindConversations(t *testing.T) {
+	http.DefaultServeMux = new(http.ServeMux)
+	defer func() { http.DefaultServeMux = new(http.ServeMux) }()
+	http.HandleFunc("/conversations.list", bulkConversationsHandler)
+
+	once.Do(startServer)
+	api := New("testing-token", OptionAPIURL("http://"+serverAddr+"/"))
+
+	channels, err := api.FindConversations(GetConversationsParameters{}, MatchNamePrefix("team-"))
+	if err != nil {
+		t.Fatalf("Unexpected error: %s", err)
+	}
+	if len(channels) != 2 || channels[0].ID != "C1" || channels[1].ID != "C3" {
+		t.Errorf("Unexpected channels: %#v", channels)
+	}
+
+	channels, err = api.FindConversations(GetConversationsParameters{}, MatchNameRegexp(regexp.MustCompile(`team-(beta|gamma)$`)))
+	if err != nil {
+		t.Fatalf("Unexpected error: %s", err)
+	}
+	if len(channels) != 2 || channels[0].ID != "C3" || channels[1].ID != "C4" {
+		t.Errorf("Unexpected channels: %#v", channels)
+	}
+}
+
+func TestBulk(t *testing.T) {
+	http.DefaultServeMux = new(http.ServeMux)
+	defer func() { http.DefaultServeMux = new(http.ServeMux) }()
+
+	var (
+		mu       sync.Mutex
+		archived []string
+		limited  bool
+	)
+	http.HandleFunc("/conversations.archive", func(rw http.ResponseWriter, r *http.Request) {
+		mu.Lock()
+		defer mu.Unlock()
+		if !limited {
+			limited = true
+			rw.Header().Set("Retry-After", "0")
+			rw.WriteHeader(http.StatusTooManyRequests)
+			return
+		}
+		rw.Header().Set("Content-Type", "application/json")
+		if r.FormValue("channel") == "C2" {
+			rw.Write([]byte(`{"ok": false, "error": "already_archived"}`))
+			return
+		}
+		archived = append(archived, r.FormValue("channel"))
+		rw.Write([]byte(`{"ok": true}`))
+	})
+
+	once.Do(startServer)
+	api := New("testing-token", OptionAPIURL("http://"+serverAddr+"/"))
+	channels := []Channel{
+		{GroupConversation: GroupConversation{Conversation: Conversation{ID: "C1"}}},
+		{GroupConversation: GroupConversation{Conversation: Conversation{ID: "C2"}}},
+		{GroupConversation: GroupConversation{Conversation: Conversation{ID: "C3"}}},
+	}
+
+	results := api.Bulk(channels, BulkArchive(), BulkParameters{DryRun: true})
+	if len(results) != 3 || len(archived) != 0 || limited {
+		t.Fatalf("Expected a dry run to leave the channels alone, got %#v", results)
+	}
+
+	results = api.Bulk(channels, BulkArchive(), BulkParameters{})
+	if len(results) != 3 {
+		t.Fatalf("Expected 3 results, got %d", len(results))
+	}
+	if !results[0].Applied || results[0].Err != nil {
+		t.Errorf("Expected C1 to be archived after the rate limit, got %#v", results[0])
+	}
+	if results[1].Applied || results[1].Err == nil || results[1].Err.Error() != "already_archived" {
+		t.Errorf("Expected C2 to fail, got %#v", results[1])
+	}
+	if !results[2].Applied {
+		t.Errorf("Expected C3 to be archived, got %#v", results[2])
+	}
+
+	archived = nil
+	results = api.Bulk(channels[1:], BulkArchive(), BulkParameters{StopOnError: true})
+	if results[0].Err == nil || results[1].Applied || len(archived) != 0 {
+		t.Errorf("Expected the run to stop at the first error, got %#v", results)
+	}
+}
+
+func TestDeleteMessages(t *testing.T) {
+	http.DefaultServeMux = new(http.ServeMux)
+	defer func() { http.DefaultServeMux = new(http.ServeMux) }()
+
+	var (
+		mu      sync.Mutex
+		deleted []string
+		limited bool
+	)
+	http.HandleFunc("/chat.delete", func(rw http.ResponseWriter, r *http.Request) {
+		mu.Lock()
+		defer mu.Unlock()
+		if !limited {
+			limited = true
+			rw.Header().Set("Retry-After", "0")
+			rw.WriteHeader(http.StatusTooManyRequests)
+			return
+		}
+		rw.Header().Set("Content-Type", "application/json")
+		switch r.FormValue("ts") {
+		case "2.000000":
+			rw.Write([]byte(`{"ok": false, "error": "message_not_found"}`))
+		case "3.000000":
+			rw.Write([]byte(`{"ok": false, "error": "cant_delete_message"}`))
+		default:
+			deleted = append(deleted, r.FormValue("channel")+"/"+r.FormValue("ts"))
+			rw.Write([]byte(`{"ok": true, "channel": "` + r.FormValue("channel") + `", "ts": "` + r.FormValue("ts") + `"}`))
+		}
+	})
+
+	once.Do(startServer)
+	api := New("testing-token", OptionAPIURL("http://"+serverAddr+"/"))
+	messages := []ItemRef{
+		NewRefToMessage("C1", "1.000000"),
+		NewRefToMessage("C1", "2.000000"),
+		NewRefToMessage("C2", "3.000000"),
+		NewRefToMessage("C2", "4.000000"),
+	}
+
+	results := api.DeleteMessages(messages, DeleteMessagesParameters{})
+	if len(results) != 4 {
+		t.Fatalf("Expected 4 results, got %d", len(results))
+	}
+	if !results[0].Deleted || results[0].Err != nil {
+		t.Errorf("Expected the first message to be deleted after the rate limit, got %#v", results[0])
+	}
+	if results[1].Deleted || !results[1].NotFound || results[1].Err != nil {
+		t.Errorf("Expected the second message to be reported as not found, got %#v", results[1])
+	}
+	if results[2].Deleted || results[2].Err == nil || results[2].Err.Error() != "cant_delete_message" {
+		t.Errorf("Expected the third message to fail, got %#v", results[2])
+	}
+	if !results[3].Deleted {
+		t.Errorf("Expected the last message to be deleted, got %#v", results[3])
+	}
+
+	deleted = nil
+	results = api.DeleteMessages(messages[1:], DeleteMessagesParameters{StopOnError: true})
+	if !results[0].NotFound || results[1].Err == nil || results[2].Deleted || len(deleted) != 0 {
+		t.Errorf("Expected the run to stop at the first error, got %#v", results)
+	}
+}
+
+func TestRemoveUsersFromConversation(t *testing.T) {
+	http.DefaultServeMux = new(http.ServeMux)
+	defer func() { http.DefaultServeMux = new(http.ServeMux) }()
+
+	var (
+		mu      sync.Mutex
+		kicked  []string
+		limited bool
+	)
+	http.HandleFunc("/conversations.kick", func(rw http.ResponseWriter, r *http.Request) {
+		mu.Lock()
+		defer mu.Unlock()
+		if !limited {
+			limited = true
+			rw.Header().Set("Retry-After", "0")
+			rw.WriteHeader(http.StatusTooManyRequests)
+			return
+		}
+		rw.Header().Set("Content-Type", "application/json")
+		switch r.FormValue("user") {
+		case "U2":
+			rw.Write([]byte(`{"ok": false, "error": "not_in_channel"}`))
+		case "U3":
+			rw.Write([]byte(`{"ok": false, "error": "cant_kick_from_general"}`))
+		default:
+			kicked = append(kicked, r.FormValue("user"))
+			rw.Write([]byte(`{"ok": true}`))
+		}
+	})
+
+	once.Do(startServer)
+	api := New("testing-token", OptionAPIURL("http://"+serverAddr+"/"))
+
+	audit := api.RemoveUsersFromConversation("C1", []string{"U1", "U2", "U3", "U4"}, RemoveUsersParameters{})
+	expected := []RemovalRecord{
+		{User: "U1", Result: RemovalRemoved},
+		{User: "U2", Result: RemovalNotInChannel},
+		{User: "U3", Result: RemovalFailed, Error: "cant_kick_from_general"},
+		{User: "U4", Result: RemovalRemoved},
+	}
+	if !reflect.DeepEqual(audit.Users, expected) {
+		t.Errorf("Unexpected records: %#v", audit.Users)
+	}
+	if audit.Channel != "C1" || audit.StartedAt.IsZero() || audit.FinishedAt.Before(audit.StartedAt) {
+		t.Errorf("Unexpected audit: %#v", audit)
+	}
+
+	kicked = nil
+	audit = api.RemoveUsersFromConversation("C1", []string{"U3", "U4"}, RemoveUsersParameters{StopOnError: true})
+	if audit.Users[0].Result != RemovalFailed || audit.Users[1].Result != RemovalSkipped || len(kicked) != 0 {
+		t.Errorf("Expected the run to stop at the first error, got %#v", audit.Users)
+	}
+}
@@ -0,0 +1,81 @@
+package slack
+
+import (
+	"context"
+	"encoding/json"
+	"net/url"
+)
+
+// callResponse decodes the envelope of a response along with the result requested by the caller.
+type callResponse struct {
+	SlackResponse
+	result interface{}
+}
+
+func (r *callResponse) UnmarshalJSON(data []byte) error {
+	if err := json.Unmarshal(data, &r.SlackResponse); err != nil {
+		return err
+	}
+	if r.result == nil {
+		return nil
+	}
+	return json.Unmarshal(data, r.result)
+}
+
+// Call calls a web API method this library doesn't wrap yet, e.g. one slack just released,
+// sending the parameters as a form. The response is decoded into result, which may be nil.
+// The client's token is added to the parameters, and the call is retried when rate limited.
+// An error is returned when the response isn't ok.
+func (api *Client) Call(method string, params url.Values, result interface{}) error {
+	return api.CallContext(context.Background(), method, params, result)
+}
+
+// CallContext calls a web API method with a custom context, sending the parameters as a form.
+// See Call.
+func (api *Client) CallContext(ctx context.Context, method string, params url.Values, result interface{}) error {
+	return retryRateLimited(ctx, api.currentClock(), func() error {
+		values := url.Values{}
+		mergeParams(values, params)
+		if values.Get("token") == "" {
+			values.Set("token", api.token)
+		}
+
+		response := &callResponse{result: result}
+		if err := api.postMethod(ctx, method, values, response); err != nil {
+			return err
+		}
+		return response.Err()
+	})
+}
+
+// CallJSON calls a web API method this library doesn't wrap yet, sending body as JSON.
+// body is encoded with encoding/json, unless it's a []byte or a json.RawMessage which are sent as is.
+// See Call.
+func (api *Client) CallJSON(method string, body interface{}, result interface{}) error {
+	return api.CallJSONContext(context.Background(), method, body, result)
+}
+
+// CallJSONContext calls a web API method with a custom context, sending body as JSON.
+// See CallJSON.
+func (api *Client) CallJSONContext(ctx context.Context, method string, body interface{}, result interface{}) error {
+	var encoded []byte
+	switch b := body.(type) {
+	case []byte:
+		encoded = b
+	case json.RawMessage:
+		encoded = b
+	default:
+		var err error
+		if encoded, err = json.Marshal(body); err != nil {
+			return err
+		}
+	}
+
+	return retryRateLimited(ctx, api.currentClock(), func() error {
+		response := &callResponse{result: result}
+		if err := api.postJSONMethod(ctx, method, encoded, response); err != nil {
+			return err
+		}
+		return response.Err()
+	})
+}
@@ -0,0 +1,78 @@
+package slack
+
+import (
+	"encoding/json"
+	"io/ioutil"
+	"net/http"
+	"net/url"
+	"testing"
+)
+
+func TestCall(t *testing.T) {
+	http.DefaultServeMux = new(http.ServeMux)
+	defer func() { http.DefaultServeMux = new(http.ServeMux) }()
+
+	attempts := 0
+	http.HandleFunc("/apps.brandNew", func(rw http.ResponseWriter, r *http.Request) {
+		attempts++
+		if attempts == 1 {
+			rw.Header().Set("Retry-After", "0")
+			rw.WriteHeader(http.StatusTooManyRequests)
+			return
+		}
+
+		rw.Header().Set("Content-Type", "application/json")
+		if r.FormValue("token") != "testing-token" || r.FormValue("name") != "widget" {
+			rw.Write([]byte(`{"ok": false, "error": "invalid_arguments"}`))
+			return
+		}
+		rw.Write([]byte(`{"ok": true, "widget": {"id": "W1", "name": "widget"}}`))
+	})
+	http.HandleFunc("/apps.brandNewJSON", func(rw http.ResponseWriter, r *http.Request) {
+		rw.Header().Set("Content-Type", "application/json")
+		body, _ := ioutil.ReadAll(r.Body)
+		var params struct {
+			Name string `json:"name"`
+		}
+		if err := json.Unmarshal(body, &params); err != nil || params.Name != "widget" || r.Header.Get("Authorization") != "Bearer testing-token" {
+			rw.Write([]byte(`{"ok": false, "error": "invalid_arguments"}`))
+			return
+		}
+		rw.Write([]byte(`{"ok": true, "widget": {"id": "W2"}}`))
+	})
+	once.Do(startServer)
+	api := New("testing-token", OptionAPIURL("http://"+serverAddr+"/"))
+
+	var result struct {
+		Widget struct {
+			ID   string `json:"id"`
+			Name string `json:"name"`
+		} `json:"widget"`
+	}
+
+	params := url.Values{"name": {"widget"}}
+	if err := api.Call("apps.brandNew", params, &result); err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	if result.Widget.ID != "W1" || attempts != 2 {
+		t.Errorf("unexpected result %+v after %d attempts", result, attempts)
+	}
+	if _, ok := params["token"]; ok {
+		t.Error("expected the parameters of the caller to be left untouched")
+	}
+
+	if err := api.Call("apps.brandNew", nil, nil); err == nil || err.Error() != "invalid_arguments" {
+		t.Errorf("expected the error of the envelope, got %v", err)
+	}
+
+	if err := api.CallJSON("apps.brandNewJSON", map[string]string{"name": "widget"}, &result); err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	if result.Widget.ID != "W2" {
+		t.Errorf("unexpected result %+v", result)
+	}
+
+	if err := api.CallJSON("apps.brandNewJSON", []byte(`{"name": "other"}`), nil); err == nil || err.Error() != "invalid_arguments" {
+		t.Errorf("expected the error of the envelope, got %v", err)
+	}
+}
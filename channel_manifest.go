@@ -0,0 +1,195 @@
+package slack
+
+import (
+	"context"
+	"strings"
+)
+
+// ChannelSpec declares the desired state of a channel, see ReconcileChannels.
+type ChannelSpec struct {
+	Name    string
+	Private bool
+	// Topic and Purpose are left alone when empty.
+	Topic   string
+	Purpose string
+	// Members are invited when the channel is created.
+	Members []string
+	// Blocks are posted when the channel is created.
+	Blocks []Block
+}
+
+// ChannelChangeType is the kind of change made by ReconcileChannels.
+type ChannelChangeType string
+
+// Changes made by ReconcileChannels.
+const (
+	ChannelChangeCreate  ChannelChangeType = "create"
+	ChannelChangeTopic   ChannelChangeType = "topic"
+	ChannelChangePurpose ChannelChangeType = "purpose"
+	ChannelChangeInvite  ChannelChangeType = "invite"
+	ChannelChangeMessage ChannelChangeType = "message"
+)
+
+// ChannelChange is a change made, or planned during a dry run, by ReconcileChannels.
+type ChannelChange struct {
+	Type ChannelChangeType
+	Name string
+	// ChannelID is empty for channels that would be created by a dry run.
+	ChannelID string
+	// Value is the new topic or purpose, or the comma separated invited users.
+	Value string
+}
+
+// ReconcileParameters controls ReconcileChannels.
+type ReconcileParameters struct {
+	// DryRun reports the changes without making them.
+	DryRun bool
+}
+
+// ReconcileChannels makes the workspace match the specs: missing channels are created, with their
+// members invited and their initial message posted, and the topic and purpose of existing channels
+// are updated when they drifted. Archived channels are ignored. Rate limited calls are retried.
+//
+// The changes made are returned, on error they stop at the change that failed.
+func (api *Client) ReconcileChannels(specs []ChannelSpec, params ReconcileParameters) ([]ChannelChange, error) {
+	return api.ReconcileChannelsContext(context.Background(), specs, params)
+}
+
+// ReconcileChannelsContext makes the workspace match the specs with a custom context.
+func (api *Client) ReconcileChannelsContext(ctx context.Context, specs []ChannelSpec, params ReconcileParameters) ([]ChannelChange, error) {
+	wanted := make(map[string]bool, len(specs))
+	for _, spec := range specs {
+		wanted[spec.Name] = true
+	}
+
+	existing, err := api.FindConversationsContext(ctx, GetConversationsParameters{
+		ExcludeArchived: "true",
+		Limit:           1000,
+		Types:           []string{"public_channel", "private_channel"},
+	}, func(c Channel) bool {
+		return wanted[c.Name]
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	channels := make(map[string]Channel, len(existing))
+	for _, channel := range existing {
+		channels[channel.Name] = channel
+	}
+
+	r := channelReconciler{api: api, dryRun: params.DryRun}
+	for _, spec := range specs {
+		channel, ok := channels[spec.Name]
+		if !ok {
+			err = r.create(ctx, spec)
+		} else {
+			err = r.update(ctx, spec, channel)
+		}
+		if err != nil {
+			return r.changes, err
+		}
+	}
+
+	return r.changes, nil
+}
+
+type channelReconciler struct {
+	api     *Client
+	dryRun  bool
+	changes []ChannelChange
+}
+
+// apply records the change, running fn unless this is a dry run.
+func (t *channelReconciler) apply(ctx context.Context, change ChannelChange, fn func() error) error {
+	if !t.dryRun {
+		if err := retryRateLimited(ctx, t.api.currentClock(), fn); err != nil {
+			return err
+		}
+	}
+
+	t.changes = append(t.changes, change)
+	return nil
+}
+
+func (t *channelReconciler) create(ctx context.Context, spec ChannelSpec) error {
+	var channelID string
+	err := t.apply(ctx, ChannelChange{Type: ChannelChangeCreate, Name: spec.Name}, func() error {
+		channel, err := t.api.CreateConversationContext(ctx, spec.Name, spec.Private)
+		if err != nil {
+			return err
+		}
+		channelID = channel.ID
+		return nil
+	})
+	if err != nil {
+		return err
+	}
+	t.changes[len(t.changes)-1].ChannelID = channelID
+
+	if err = t.setTopic(ctx, spec, channelID); err != nil {
+		return err
+	}
+	if err = t.setPurpose(ctx, spec, channelID); err != nil {
+		return err
+	}
+
+	if len(spec.Members) > 0 {
+		change := ChannelChange{Type: ChannelChangeInvite, Name: spec.Name, ChannelID: channelID, Value: strings.Join(spec.Members, ",")}
+		err = t.apply(ctx, change, func() error {
+			_, err := t.api.InviteUsersToConversationContext(ctx, channelID, spec.Members...)
+			return err
+		})
+		if err != nil {
+			return err
+		}
+	}
+
+	if len(spec.Blocks) > 0 {
+		change := ChannelChange{Type: ChannelChangeMessage, Name: spec.Name, ChannelID: channelID}
+		return t.apply(ctx, change, func() error {
+			_, _, err := t.api.PostMessageContext(ctx, channelID, MsgOptionBlocks(spec.Blocks...))
+			return err
+		})
+	}
+
+	return nil
+}
+
+func (t *channelReconciler) update(ctx context.Context, spec ChannelSpec, channel Channel) error {
+	if spec.Topic != "" && spec.Topic != channel.Topic.Value {
+		if err := t.setTopic(ctx, spec, channel.ID); err != nil {
+			return err
+		}
+	}
+
+	if spec.Purpose != "" && spec.Purpose != channel.Purpose.Value {
+		return t.setPurpose(ctx, spec, channel.ID)
+	}
+
+	return nil
+}
+
+func (t *channelReconciler) setTopic(ctx context.Context, spec ChannelSpec, channelID string) error {
+	if spec.Topic == "" {
+		return nil
+	}
+
+	change := ChannelChange{Type: ChannelChangeTopic, Name: spec.Name, ChannelID: channelID, Value: spec.Topic}
+	return t.apply(ctx, change, func() error {
+		_, err := t.api.SetTopicOfConversationContext(ctx, channelID, spec.Topic)
+		return err
+	})
+}
+
+func (t *channelReconciler) setPurpose(ctx context.Context, spec ChannelSpec, channelID string) error {
+	if spec.Purpose == "" {
+		return nil
+	}
+
+	change := ChannelChange{Type: ChannelChangePurpose, Name: spec.Name, ChannelID: channelID, Value: spec.Purpose}
+	return t.apply(ctx, change, func() error {
+		_, err := t.api.SetPurposeOfConversationContext(ctx, channelID, spec.Purpose)
+		return err
+	})
+}
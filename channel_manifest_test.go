@@ -0,0 +1,86 @@
+package slack
+
+import (
+	"net/http"
+	"reflect"
+	"testing"
+)
+
+func TestReconcileChannels(t *testing.T) {
+	http.DefaultServeMux = new(http.ServeMux)
+	defer func() { http.DefaultServeMux = new(http.ServeMux) }()
+
+	var calls []string
+	record := func(response string) http.HandlerFunc {
+		return func(rw http.ResponseWriter, r *http.Request) {
+			r.ParseForm()
+			calls = append(calls, r.URL.Path[1:]+" "+r.Form.Get("channel")+r.Form.Get("name"))
+			rw.Header().Set("Content-Type", "application/json")
+			rw.Write([]byte(response))
+		}
+	}
+	http.HandleFunc("/conversations.list", func(rw http.ResponseWriter, r *http.Request) {
+		rw.Header().Set("Content-Type", "application/json")
+		rw.Write([]byte(`{"ok": true, "channels": [
+			{"id": "C1", "name": "team-alpha", "topic": {"value": "old topic"}, "purpose": {"value": "Alpha"}},
+			{"id": "C2", "name": "random"}
+		], "response_metadata": {"next_cursor": ""}}`))
+	})
+	http.HandleFunc("/conversations.create", record(`{"ok": true, "channel": {"id": "C3", "name": "team-beta"}}`))
+	http.HandleFunc("/conversations.setTopic", record(`{"ok": true, "channel": {}}`))
+	http.HandleFunc("/conversations.setPurpose", record(`{"ok": true, "channel": {}}`))
+	http.HandleFunc("/conversations.invite", record(`{"ok": true, "channel": {}}`))
+	http.HandleFunc("/chat.postMessage", record(`{"ok": true, "channel": "C3", "ts": "1.0"}`))
+
+	once.Do(startServer)
+	api := New("testing-token", OptionAPIURL("http://"+serverAddr+"/"))
+
+	specs := []ChannelSpec{
+		{Name: "team-alpha", Topic: "new topic", Purpose: "Alpha"},
+		{
+			Name:    "team-beta",
+			Topic:   "Beta",
+			Members: []string{"U1", "U2"},
+			Blocks:  []Block{NewDividerBlock()},
+		},
+	}
+
+	changes, err := api.ReconcileChannels(specs, ReconcileParameters{DryRun: true})
+	if err != nil {
+		t.Fatalf("Unexpected error: %s", err)
+	}
+	if len(calls) != 0 {
+		t.Fatalf("Expected a dry run not to change anything, got %v", calls)
+	}
+	expected := []ChannelChange{
+		{Type: ChannelChangeTopic, Name: "team-alpha", ChannelID: "C1", Value: "new topic"},
+		{Type: ChannelChangeCreate, Name: "team-beta"},
+		{Type: ChannelChangeTopic, Name: "team-beta", Value: "Beta"},
+		{Type: ChannelChangeInvite, Name: "team-beta", Value: "U1,U2"},
+		{Type: ChannelChangeMessage, Name: "team-beta"},
+	}
+	if !reflect.DeepEqual(expected, changes) {
+		t.Fatalf("Expected %#v, got %#v", expected, changes)
+	}
+
+	changes, err = api.ReconcileChannels(specs, ReconcileParameters{})
+	if err != nil {
+		t.Fatalf("Unexpected error: %s", err)
+	}
+	for i := range expected[1:] {
+		expected[i+1].ChannelID = "C3"
+	}
+	if !reflect.DeepEqual(expected, changes) {
+		t.Fatalf("Expected %#v, got %#v", expected, changes)
+	}
+	expectedCalls := []string{
+		"conversations.setTopic C1",
+		"conversations.create team-beta",
+		"conversations.setTopic C3",
+		"conversations.invite C3",
+		"chat.postMessage C3",
+	}
+	if !reflect.DeepEqual(expectedCalls, calls) {
+		t.Fatalf("Expected calls %v, got %v", expectedCalls, calls)
+	}
+}
@@ -4,7 +4,6 @@ import (
 	"context"
 	"net/url"
 	"strconv"
-	"time"
 )
 
 type channelResponseFull struct {
@@ -29,7 +28,7 @@ type Channel struct {
 
 func (api *Client) channelRequest(ctx context.Context, path string, values url.Values) (*channelResponseFull, error) {
 	response := &channelResponseFull{}
-	err := postForm(ctx, api.httpclient, api.endpoint+path, values, response, api)
+	err := api.postMethod(ctx, path, values, response)
 	if err != nil {
 		return nil, err
 	}
@@ -358,7 +357,7 @@ func (api *Client) GetChannelsContext(ctx context.Context, excludeArchived bool,
 			select {
 			case <-ctx.Done():
 				err = ctx.Err()
-			case <-time.After(rateLimitedError.RetryAfter):
+			case <-api.currentClock().After(rateLimitedError.RetryAfter):
 				err = nil
 			}
 		}
@@ -8,6 +8,7 @@ import (
 	"net/http"
 	"net/url"
 	"strconv"
+	"time"
 
 	"github.com/slack-go/slack/slackutilsx"
 )
@@ -117,22 +118,74 @@ func (api *Client) ScheduleMessage(channelID, postAt string, options ...MsgOptio
 	return respChannel, respTimestamp, err
 }
 
-// PostMessage sends a message to a channel.
-// Message is escaped by default according to https://api.slack.com/docs/formatting
-// Use http://davestevens.github.io/slack-message-builder/ to help crafting your message.
-func (api *Client) PostMessage(channelID string, options ...MsgOption) (string, string, error) {
+// SchedulePostAtLocalTime schedules a message with the blocks for the wall clock time of localTime in the tz timezone,
+// e.g. 9am in Europe/Paris, its own location is ignored. A time skipped by a daylight saving transition is moved
+// past the transition, a time repeated by one is scheduled at its first occurrence.
+func (api *Client) SchedulePostAtLocalTime(channelID string, blocks []Block, localTime time.Time, tz string, options ...MsgOption) (string, string, error) {
+	return api.SchedulePostAtLocalTimeContext(context.Background(), channelID, blocks, localTime, tz, options...)
+}
+
+// SchedulePostAtLocalTimeContext schedules a message at a local time with a custom context.
+// For more details, see SchedulePostAtLocalTime documentation.
+func (api *Client) SchedulePostAtLocalTimeContext(ctx context.Context, channelID string, blocks []Block, localTime time.Time, tz string, options ...MsgOption) (string, string, error) {
+	loc, err := time.LoadLocation(tz)
+	if err != nil {
+		return "", "", err
+	}
+
+	postAt := wallClockIn(localTime, loc)
 	respChannel, respTimestamp, _, err := api.SendMessageContext(
-		context.Background(),
+		ctx,
 		channelID,
-		MsgOptionPost(),
+		MsgOptionSchedule(strconv.FormatInt(postAt.Unix(), 10)),
+		MsgOptionBlocks(blocks...),
 		MsgOptionCompose(options...),
 	)
 	return respChannel, respTimestamp, err
 }
 
+// wallClockIn returns the time at which the clocks in loc show the wall clock time of t.
+func wallClockIn(t time.Time, loc *time.Location) time.Time {
+	local := time.Date(t.Year(), t.Month(), t.Day(), t.Hour(), t.Minute(), t.Second(), 0, loc)
+	_, before := local.Add(-time.Hour).Zone()
+	_, after := local.Add(time.Hour).Zone()
+	if before == after {
+		return local
+	}
+
+	// close to a transition time.Date doesn't guarantee which offset it uses for a wall clock time
+	// repeated or skipped by the transition, so the time is resolved with each offset explicitly.
+	wall := time.Date(t.Year(), t.Month(), t.Day(), t.Hour(), t.Minute(), t.Second(), 0, time.UTC)
+	earlier := wall.Add(-time.Duration(before) * time.Second).In(loc)
+	if _, offset := earlier.Zone(); offset == before {
+		// a repeated time shows up with both offsets, the earlier instant is its first occurrence.
+		return earlier
+	}
+	later := wall.Add(-time.Duration(after) * time.Second).In(loc)
+	if _, offset := later.Zone(); offset == after {
+		return later
+	}
+	// the wall clock time was skipped, e.g. by the start of daylight saving: with the offset in effect
+	// before the transition it's moved past the transition by the length of the gap.
+	return earlier
+}
+
+// PostMessage sends a message to a channel.
+// Message is escaped by default according to https://api.slack.com/docs/formatting
+// Use http://davestevens.github.io/slack-message-builder/ to help crafting your message.
+func (api *Client) PostMessage(channelID string, options ...MsgOption) (string, string, error) {
+	return api.PostMessageContext(context.Background(), channelID, options...)
+}
+
 // PostMessageContext sends a message to a channel with a custom context
 // For more details, see PostMessage documentation.
 func (api *Client) PostMessageContext(ctx context.Context, channelID string, options ...MsgOption) (string, string, error) {
+	if api.channelLimiter != nil {
+		if err := api.channelLimiter.wait(ctx, channelID, api.currentClock()); err != nil {
+			return "", "", err
+		}
+	}
+
 	respChannel, respTimestamp, _, err := api.SendMessageContext(
 		ctx,
 		channelID,
@@ -186,6 +239,60 @@ func (api *Client) UpdateMessageContext(ctx context.Context, channelID, timestam
 	)
 }
 
+// MessageMutation computes the options used to update msg, the current version of the message.
+type MessageMutation func(msg Message) ([]MsgOption, error)
+
+// defaultSwapAttempts is used by SwapMessage when no attempts are requested.
+const defaultSwapAttempts = 3
+
+// SwapMessage updates a message based on its current content, for messages several workers update concurrently.
+// The message is fetched, passed to mutate, and only updated if it hasn't been edited since it was fetched,
+// otherwise the process is repeated. After attempts conflicting edits ErrUpdateConflict is returned.
+// Slack has no conditional update, so a concurrent edit landing between the check and the update can still be lost.
+func (api *Client) SwapMessage(channelID, timestamp string, attempts int, mutate MessageMutation) (string, string, string, error) {
+	return api.SwapMessageContext(context.Background(), channelID, timestamp, attempts, mutate)
+}
+
+// SwapMessageContext updates a message based on its current content with a custom context.
+// For more details, see SwapMessage documentation.
+func (api *Client) SwapMessageContext(ctx context.Context, channelID, timestamp string, attempts int, mutate MessageMutation) (string, string, string, error) {
+	if attempts <= 0 {
+		attempts = defaultSwapAttempts
+	}
+
+	for i := 0; i < attempts; i++ {
+		current, err := api.GetMessageContextContext(ctx, channelID, timestamp, 0, 0)
+		if err != nil {
+			return "", "", "", err
+		}
+
+		options, err := mutate(current.Target)
+		if err != nil {
+			return "", "", "", err
+		}
+
+		latest, err := api.GetMessageContextContext(ctx, channelID, timestamp, 0, 0)
+		if err != nil {
+			return "", "", "", err
+		}
+		if messageVersion(latest.Target) != messageVersion(current.Target) {
+			continue
+		}
+
+		return api.UpdateMessageContext(ctx, channelID, timestamp, options...)
+	}
+
+	return "", "", "", ErrUpdateConflict
+}
+
+// messageVersion identifies the revision of a message.
+func messageVersion(msg Message) string {
+	if msg.Edited == nil {
+		return msg.Text
+	}
+	return msg.Edited.Timestamp + ":" + msg.Text
+}
+
 // UnfurlMessage unfurls a message in a channel
 func (api *Client) UnfurlMessage(channelID, timestamp string, unfurls map[string]Attachment, options ...MsgOption) (string, string, string, error) {
 	return api.SendMessageContext(context.Background(), channelID, MsgOptionUnfurl(timestamp, unfurls), MsgOptionCompose(options...))
@@ -204,7 +311,24 @@ func (api *Client) SendMessageContext(ctx context.Context, channelID string, opt
 		response chatResponseFull
 	)
 
-	if req, parser, err = buildSender(api.endpoint, options...).BuildRequest(api.token, channelID); err != nil {
+	token, err := api.currentToken(ctx)
+	if err != nil {
+		return "", "", "", err
+	}
+
+	if params := contextParams(ctx); len(params) > 0 {
+		options = append(options[:len(options):len(options)], msgOptionContextParams(params))
+	}
+
+	if api.jsonBody {
+		options = append(options[:len(options):len(options)], msgOptionJSONBody())
+	}
+
+	if api.broadcastGuard != BroadcastAllow {
+		options = append(options[:len(options):len(options)], msgOptionBroadcastGuard(api.broadcastGuard))
+	}
+
+	if req, parser, err = buildSender(api.endpoint, options...).BuildRequest(token, channelID); err != nil {
 		return "", "", "", err
 	}
 
@@ -214,7 +338,7 @@ func (api *Client) SendMessageContext(ctx context.Context, channelID string, opt
 			return "", "", "", err
 		}
 		req.Body = ioutil.NopCloser(bytes.NewBuffer(reqBody))
-		api.Debugf("Sending request: %s", string(reqBody))
+		api.Debugf("Sending request: %s", redactForDebug(api, req.Header.Get("Content-Type"), reqBody))
 	}
 
 	if err = doPost(ctx, api.httpclient, req, parser(&response), api); err != nil {
@@ -282,6 +406,8 @@ type sendConfig struct {
 	responseType    string
 	replaceOriginal bool
 	deleteOriginal  bool
+	allowBroadcast  bool
+	jsonBody        bool
 }
 
 func (t sendConfig) BuildRequest(token, channelID string) (req *http.Request, _ func(*chatResponseFull) responseParser, err error) {
@@ -301,6 +427,9 @@ func (t sendConfig) BuildRequest(token, channelID string) (req *http.Request, _
 			deleteOriginal:  t.deleteOriginal,
 		}.BuildRequest()
 	default:
+		if t.jsonBody && (t.values.Get("blocks") != "" || t.values.Get("attachments") != "") {
+			return jsonSender{endpoint: t.endpoint, values: t.values}.BuildRequest()
+		}
 		return formSender{endpoint: t.endpoint, values: t.values}.BuildRequest()
 	}
 }
@@ -317,6 +446,57 @@ func (t formSender) BuildRequest() (*http.Request, func(*chatResponseFull) respo
 	}, err
 }
 
+// jsonFields are the parameters of chat methods holding JSON, which are embedded as is in JSON bodies.
+var jsonFields = map[string]bool{
+	"attachments": true,
+	"blocks":      true,
+	"unfurls":     true,
+}
+
+// booleanFields are the parameters of chat methods holding booleans, form encoded as true or 1.
+var booleanFields = map[string]bool{
+	"as_user":         true,
+	"link_names":      true,
+	"mrkdwn":          true,
+	"reply_broadcast": true,
+	"unfurl_links":    true,
+	"unfurl_media":    true,
+}
+
+// jsonSender sends the parameters of a chat method as a JSON body, the token being sent
+// in the Authorization header.
+type jsonSender struct {
+	endpoint string
+	values   url.Values
+}
+
+func (t jsonSender) BuildRequest() (*http.Request, func(*chatResponseFull) responseParser, error) {
+	body := make(map[string]interface{}, len(t.values))
+	for key, values := range t.values {
+		switch {
+		case key == "token" || len(values) == 0:
+		case jsonFields[key]:
+			body[key] = json.RawMessage(values[0])
+		case booleanFields[key]:
+			body[key] = values[0] == "true" || values[0] == "1"
+		case len(values) == 1:
+			body[key] = values[0]
+		default:
+			body[key] = values
+		}
+	}
+
+	req, err := jsonReq(t.endpoint, body)
+	if err != nil {
+		return nil, nil, err
+	}
+	req.Header.Set("Authorization", "Bearer "+t.values.Get("token"))
+
+	return req, func(resp *chatResponseFull) responseParser {
+		return newJSONParser(resp)
+	}, nil
+}
+
 type responseURLSender struct {
 	endpoint        string
 	values          url.Values
@@ -345,6 +525,21 @@ func (t responseURLSender) BuildRequest() (*http.Request, func(*chatResponseFull
 // MsgOption option provided when sending a message.
 type MsgOption func(*sendConfig) error
 
+// OptionJSONBody sends the messages carrying blocks or attachments as JSON bodies rather than forms,
+// which some newer features of chat methods require, and which avoids encoding nested blocks as strings.
+func OptionJSONBody(b bool) func(*Client) {
+	return func(c *Client) {
+		c.jsonBody = b
+	}
+}
+
+func msgOptionJSONBody() MsgOption {
+	return func(config *sendConfig) error {
+		config.jsonBody = true
+		return nil
+	}
+}
+
 // MsgOptionSchedule schedules a messages.
 func MsgOptionSchedule(postAt string) MsgOption {
 	return func(config *sendConfig) error {
@@ -6,7 +6,10 @@ import (
 	"net/http"
 	"net/url"
 	"reflect"
+	"strconv"
+	"strings"
 	"testing"
+	"time"
 )
 
 func postMessageInvalidChannelHandler(rw http.ResponseWriter, r *http.Request) {
@@ -219,3 +222,157 @@ func TestPostMessageWhenMsgOptionDeleteOriginalApplied(t *testing.T) {
 
 	_, _, _ = api.PostMessage("CXXX", MsgOptionDeleteOriginal(responseURL))
 }
+
+func TestSwapMessage(t *testing.T) {
+	http.DefaultServeMux = new(http.ServeMux)
+	defer func() { http.DefaultServeMux = new(http.ServeMux) }()
+
+	// the second fetch observes a concurrent edit, forcing a retry.
+	versions := []string{"1", "2", "2", "2"}
+	fetches := 0
+	http.HandleFunc("/conversations.history", func(rw http.ResponseWriter, r *http.Request) {
+		rw.Header().Set("Content-Type", "application/json")
+		version := versions[len(versions)-1]
+		if fetches < len(versions) {
+			version = versions[fetches]
+		}
+		fetches++
+		rw.Write([]byte(`{"ok": true, "messages": [{"ts": "1500000000.000001", "text": "` + version + `"}]}`))
+	})
+
+	var updated string
+	http.HandleFunc("/chat.update", func(rw http.ResponseWriter, r *http.Request) {
+		updated = r.FormValue("text")
+		rw.Header().Set("Content-Type", "application/json")
+		rw.Write([]byte(`{"ok": true, "channel": "C1", "ts": "1500000000.000001", "text": "` + updated + `"}`))
+	})
+
+	once.Do(startServer)
+	api := New(validToken, OptionAPIURL("http://"+serverAddr+"/"))
+
+	increment := func(msg Message) ([]MsgOption, error) {
+		return []MsgOption{MsgOptionText(msg.Text+"+1", false)}, nil
+	}
+
+	if _, _, _, err := api.SwapMessage("C1", "1500000000.000001", 3, increment); err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	if updated != "2+1" {
+		t.Errorf("expected the update to be based on the latest version, got %q", updated)
+	}
+
+	fetches, versions = 0, []string{"1", "2"}
+	if _, _, _, err := api.SwapMessage("C1", "1500000000.000001", 1, increment); err != ErrUpdateConflict {
+		t.Errorf("expected %v, got %v", ErrUpdateConflict, err)
+	}
+}
+
+func TestWallClockIn(t *testing.T) {
+	for _, tt := range []struct {
+		name string
+		zone string
+		wall time.Time
+		want string
+	}{
+		{"standard", "America/New_York", time.Date(2021, 1, 4, 9, 0, 0, 0, time.UTC), "2021-01-04T09:00:00-05:00"},
+		{"daylight saving", "America/New_York", time.Date(2021, 7, 5, 9, 0, 0, 0, time.UTC), "2021-07-05T09:00:00-04:00"},
+		{"skipped", "America/New_York", time.Date(2021, 3, 14, 2, 30, 0, 0, time.UTC), "2021-03-14T03:30:00-04:00"},
+		{"after skipped", "America/New_York", time.Date(2021, 3, 14, 3, 15, 0, 0, time.UTC), "2021-03-14T03:15:00-04:00"},
+		{"repeated", "America/New_York", time.Date(2021, 11, 7, 1, 30, 0, 0, time.UTC), "2021-11-07T01:30:00-04:00"},
+		{"after repeated", "America/New_York", time.Date(2021, 11, 7, 2, 15, 0, 0, time.UTC), "2021-11-07T02:15:00-05:00"},
+		{"skipped east of UTC", "Europe/Paris", time.Date(2026, 3, 29, 2, 30, 0, 0, time.UTC), "2026-03-29T03:30:00+02:00"},
+		{"repeated east of UTC", "Europe/Paris", time.Date(2026, 10, 25, 2, 30, 0, 0, time.UTC), "2026-10-25T02:30:00+02:00"},
+		{"after repeated east of UTC", "Europe/Paris", time.Date(2026, 10, 25, 3, 15, 0, 0, time.UTC), "2026-10-25T03:15:00+01:00"},
+		{"repeated southern hemisphere", "Australia/Sydney", time.Date(2026, 4, 5, 2, 30, 0, 0, time.UTC), "2026-04-05T02:30:00+11:00"},
+		{"skipped southern hemisphere", "Australia/Sydney", time.Date(2026, 10, 4, 2, 30, 0, 0, time.UTC), "2026-10-04T03:30:00+11:00"},
+	} {
+		loc, err := time.LoadLocation(tt.zone)
+		if err != nil {
+			t.Skipf("timezone data unavailable: %s", err)
+		}
+		if got := wallClockIn(tt.wall, loc).Format(time.RFC3339); got != tt.want {
+			t.Errorf("%s: expected %s, got %s", tt.name, tt.want, got)
+		}
+	}
+}
+
+func TestSchedulePostAtLocalTime(t *testing.T) {
+	http.DefaultServeMux = new(http.ServeMux)
+	defer func() { http.DefaultServeMux = new(http.ServeMux) }()
+
+	var postAt string
+	http.HandleFunc("/chat.scheduleMessage", func(rw http.ResponseWriter, r *http.Request) {
+		postAt = r.FormValue("post_at")
+		rw.Header().Set("Content-Type", "application/json")
+		rw.Write([]byte(`{"ok": true, "channel": "C1", "scheduled_message_id": "Q1", "post_at": "` + postAt + `"}`))
+	})
+
+	once.Do(startServer)
+	api := New(validToken, OptionAPIURL("http://"+serverAddr+"/"))
+
+	blocks := []Block{NewDividerBlock()}
+	if _, _, err := api.SchedulePostAtLocalTime("C1", blocks, time.Date(2021, 6, 1, 9, 0, 0, 0, time.UTC), "Europe/Paris"); err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	// 9am in Paris is 7am UTC in summer.
+	if want := strconv.FormatInt(time.Date(2021, 6, 1, 7, 0, 0, 0, time.UTC).Unix(), 10); postAt != want {
+		t.Errorf("expected post_at %s, got %s", want, postAt)
+	}
+
+	if _, _, err := api.SchedulePostAtLocalTime("C1", blocks, time.Now(), "Not/AZone"); err == nil {
+		t.Error("expected an error for an unknown timezone")
+	}
+}
+
+func TestPostMessageJSONBody(t *testing.T) {
+	http.DefaultServeMux = new(http.ServeMux)
+	defer func() { http.DefaultServeMux = new(http.ServeMux) }()
+
+	var (
+		contentType   string
+		authorization string
+		body          map[string]interface{}
+	)
+	http.HandleFunc("/chat.postMessage", func(rw http.ResponseWriter, r *http.Request) {
+		contentType, authorization = r.Header.Get("Content-Type"), r.Header.Get("Authorization")
+		body = nil
+		if strings.HasPrefix(contentType, "application/json") {
+			json.NewDecoder(r.Body).Decode(&body)
+		}
+		rw.Header().Set("Content-Type", "application/json")
+		rw.Write([]byte(`{"ok": true, "channel": "CXXX", "ts": "1234567890.000100"}`))
+	})
+	once.Do(startServer)
+	api := New("testing-token", OptionAPIURL("http://"+serverAddr+"/"), OptionJSONBody(true))
+
+	blocks := []Block{NewSectionBlock(NewTextBlockObject(MarkdownType, "héllo & <wörld>", false, false), nil, nil)}
+	_, _, err := api.PostMessage("CXXX", MsgOptionBlocks(blocks...), MsgOptionText("héllo", false), MsgOptionEnableLinkUnfurl(), MsgOptionTS("1234.5678"))
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	if contentType != "application/json; charset=utf-8" || authorization != "Bearer testing-token" {
+		t.Errorf("unexpected headers: %q %q", contentType, authorization)
+	}
+	expected := map[string]interface{}{
+		"channel":      "CXXX",
+		"text":         "héllo",
+		"thread_ts":    "1234.5678",
+		"unfurl_links": true,
+		"blocks": []interface{}{map[string]interface{}{
+			"type": "section",
+			"text": map[string]interface{}{"type": "mrkdwn", "text": "héllo & <wörld>"},
+		}},
+	}
+	if !reflect.DeepEqual(body, expected) {
+		t.Errorf("expected %#v, got %#v", expected, body)
+	}
+
+	// messages without blocks or attachments are still sent as forms.
+	if _, _, err = api.PostMessage("CXXX", MsgOptionText("hello", false)); err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	if contentType != "application/x-www-form-urlencoded" {
+		t.Errorf("expected a form, got %q", contentType)
+	}
+}
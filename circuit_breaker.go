@@ -0,0 +1,221 @@
+package slack
+
+import (
+	"context"
+	"fmt"
+	"math/rand"
+	"net/http"
+	"path"
+	"sync"
+	"time"
+)
+
+// CircuitState is the state of the circuit of a web API method.
+type CircuitState int
+
+const (
+	// CircuitClosed lets the calls through, this is the normal state.
+	CircuitClosed CircuitState = iota
+	// CircuitOpen fails the calls with a *CircuitOpenError until the cooldown has elapsed.
+	CircuitOpen
+	// CircuitHalfOpen lets a single probe through, its outcome closes or reopens the circuit.
+	CircuitHalfOpen
+)
+
+func (s CircuitState) String() string {
+	switch s {
+	case CircuitOpen:
+		return "open"
+	case CircuitHalfOpen:
+		return "half-open"
+	default:
+		return "closed"
+	}
+}
+
+// CircuitOpenError is returned instead of calling a method whose circuit is open.
+type CircuitOpenError struct {
+	Method string
+	// RetryAfter is the remaining cooldown, jittered so that clients don't all probe at once.
+	RetryAfter time.Duration
+}
+
+func (e *CircuitOpenError) Error() string {
+	return fmt.Sprintf("slack circuit open for %s, retry after %s", e.Method, e.RetryAfter)
+}
+
+func (e *CircuitOpenError) Retryable() bool {
+	return true
+}
+
+// CircuitBreaker stops calling a web API method after consecutive server errors or
+// timeouts, protecting slack and the application during outages. Once the cooldown has
+// elapsed a single probe is let through: it closes the circuit when it succeeds, and
+// reopens it for twice as long when it fails, up to the maximum cooldown.
+// Other responses, including rate limits and API errors, count as successes.
+type CircuitBreaker struct {
+	threshold   int
+	cooldown    time.Duration
+	maxCooldown time.Duration
+
+	mu       sync.Mutex
+	circuits map[string]*circuit
+}
+
+type circuit struct {
+	state     CircuitState
+	failures  int
+	opens     int
+	openUntil time.Time
+}
+
+// NewCircuitBreaker opens the circuit of a method after threshold consecutive failures,
+// for cooldown at first and at most maxCooldown, plus up to half of it as jitter.
+func NewCircuitBreaker(threshold int, cooldown, maxCooldown time.Duration) *CircuitBreaker {
+	if threshold < 1 {
+		threshold = 1
+	}
+	if maxCooldown < cooldown {
+		maxCooldown = cooldown
+	}
+
+	return &CircuitBreaker{
+		threshold:   threshold,
+		cooldown:    cooldown,
+		maxCooldown: maxCooldown,
+		circuits:    map[string]*circuit{},
+	}
+}
+
+// OptionCircuitBreaker guards the web API calls of the client with the circuit breaker.
+// A breaker may be shared by several clients, e.g. one per workspace.
+func OptionCircuitBreaker(breaker *CircuitBreaker) func(*Client) {
+	return func(c *Client) {
+		c.circuitBreaker = breaker
+	}
+}
+
+// State returns the state of the circuit of the given method, e.g. chat.postMessage.
+func (b *CircuitBreaker) State(method string) CircuitState {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	if c, ok := b.circuits[method]; ok {
+		return c.state
+	}
+	return CircuitClosed
+}
+
+// allow reports whether a call to the method may proceed, returning the error to fail it with otherwise.
+func (b *CircuitBreaker) allow(method string, now time.Time) error {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	c, ok := b.circuits[method]
+	if !ok {
+		return nil
+	}
+
+	switch c.state {
+	case CircuitOpen:
+		if now.Before(c.openUntil) {
+			return &CircuitOpenError{Method: method, RetryAfter: c.openUntil.Sub(now)}
+		}
+		c.state = CircuitHalfOpen
+		return nil
+	case CircuitHalfOpen:
+		// a probe is already in flight.
+		return &CircuitOpenError{Method: method, RetryAfter: b.cooldown}
+	default:
+		return nil
+	}
+}
+
+// record updates the circuit of the method with the outcome of a call.
+func (b *CircuitBreaker) record(method string, failed bool, now time.Time) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	c, ok := b.circuits[method]
+	if !failed {
+		if ok {
+			delete(b.circuits, method)
+		}
+		return
+	}
+
+	if !ok {
+		c = &circuit{}
+		b.circuits[method] = c
+	}
+
+	c.failures++
+	if c.state != CircuitHalfOpen && c.failures < b.threshold {
+		return
+	}
+
+	c.state = CircuitOpen
+	c.openUntil = now.Add(b.nextCooldown(c.opens))
+	c.opens++
+}
+
+// release lets another probe through when one was abandoned.
+func (b *CircuitBreaker) release(method string) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	if c, ok := b.circuits[method]; ok && c.state == CircuitHalfOpen {
+		c.state = CircuitOpen
+	}
+}
+
+// nextCooldown doubles the cooldown for every time the circuit reopened, adding jitter.
+func (b *CircuitBreaker) nextCooldown(opens int) time.Duration {
+	d := b.cooldown
+	for i := 0; i < opens && d < b.maxCooldown; i++ {
+		d *= 2
+	}
+	if d > b.maxCooldown {
+		d = b.maxCooldown
+	}
+
+	if jitter := int64(d / 2); jitter > 0 {
+		d += time.Duration(rand.Int63n(jitter))
+	}
+	return d
+}
+
+// breakerClient guards the requests sent by an http client with a circuit breaker.
+type breakerClient struct {
+	client  httpClient
+	breaker *CircuitBreaker
+	clock   Clock
+}
+
+func (c breakerClient) Do(req *http.Request) (*http.Response, error) {
+	method := path.Base(req.URL.Path)
+	if err := c.breaker.allow(method, c.clock.Now()); err != nil {
+		return nil, err
+	}
+
+	resp, err := c.client.Do(req)
+
+	// calls cancelled by the application say nothing about the health of slack.
+	if err != nil && req.Context().Err() == context.Canceled {
+		c.breaker.release(method)
+		return resp, err
+	}
+
+	c.breaker.record(method, err != nil || resp.StatusCode >= http.StatusInternalServerError, c.clock.Now())
+	return resp, err
+}
+
+// configureCircuitBreaker wraps the http client with the circuit breaker of the client,
+// it must be called after configureTransport which requires an *http.Client.
+func (api *Client) configureCircuitBreaker() {
+	if api.circuitBreaker == nil {
+		return
+	}
+
+	api.httpclient = breakerClient{client: api.httpclient, breaker: api.circuitBreaker, clock: api.currentClock()}
+}
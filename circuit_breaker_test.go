@@ -0,0 +1,83 @@
+package slack
+
+import (
+	"context"
+	"net/http"
+	"net/url"
+	"testing"
+	"time"
+)
+
+func TestCircuitBreaker(t *testing.T) {
+	http.DefaultServeMux = new(http.ServeMux)
+	defer func() { http.DefaultServeMux = new(http.ServeMux) }()
+
+	healthy := false
+	calls := 0
+	http.HandleFunc("/auth.test", func(rw http.ResponseWriter, r *http.Request) {
+		calls++
+		if !healthy {
+			rw.WriteHeader(http.StatusServiceUnavailable)
+			return
+		}
+		rw.Header().Set("Content-Type", "application/json")
+		rw.Write([]byte(`{"ok": true}`))
+	})
+	http.HandleFunc("/api.test", func(rw http.ResponseWriter, r *http.Request) {
+		rw.Header().Set("Content-Type", "application/json")
+		rw.Write([]byte(`{"ok": true}`))
+	})
+	once.Do(startServer)
+
+	clock := NewManualClock(time.Date(2020, 1, 1, 12, 0, 0, 0, time.UTC))
+	breaker := NewCircuitBreaker(2, time.Second, time.Minute)
+	api := New(validToken, OptionAPIURL("http://"+serverAddr+"/"), OptionCircuitBreaker(breaker), OptionClock(clock))
+
+	for i := 0; i < 2; i++ {
+		if _, err := api.AuthTest(); err == nil {
+			t.Fatal("expected an error")
+		}
+	}
+	if state := breaker.State("auth.test"); state != CircuitOpen {
+		t.Fatalf("expected the circuit to be open, got %s", state)
+	}
+
+	_, err := api.AuthTest()
+	openErr, ok := err.(*CircuitOpenError)
+	if !ok {
+		t.Fatalf("expected a *CircuitOpenError, got %v", err)
+	}
+	if openErr.Method != "auth.test" || openErr.RetryAfter < time.Second || openErr.RetryAfter >= 1500*time.Millisecond {
+		t.Errorf("unexpected error %#v", openErr)
+	}
+	if calls != 2 {
+		t.Errorf("expected 2 calls to reach the server, got %d", calls)
+	}
+
+	// other methods aren't affected.
+	if err := api.postMethod(context.Background(), "api.test", url.Values{}, &SlackResponse{}); err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	// the failed probe reopens the circuit for longer.
+	clock.Advance(1500 * time.Millisecond)
+	if _, err := api.AuthTest(); err == nil {
+		t.Fatal("expected an error")
+	}
+	_, err = api.AuthTest()
+	if openErr, ok := err.(*CircuitOpenError); !ok || openErr.RetryAfter < 2*time.Second {
+		t.Fatalf("expected the circuit to reopen for at least 2s, got %v", err)
+	}
+
+	healthy = true
+	clock.Advance(3 * time.Second)
+	if _, err := api.AuthTest(); err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	if state := breaker.State("auth.test"); state != CircuitClosed {
+		t.Errorf("expected the circuit to be closed, got %s", state)
+	}
+	if calls != 4 {
+		t.Errorf("expected 4 calls to reach the server, got %d", calls)
+	}
+}
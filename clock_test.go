@@ -0,0 +1,124 @@
+package slack
+
+import (
+	"context"
+	"testing"
+	"time"
+)
+
+// waitForWaiters blocks until the clock has n pending waits, failing the test after a second.
+func waitForWaiters(t *testing.T, clock *ManualClock, n int) {
+	t.Helper()
+	deadline := time.Now().Add(time.Second)
+	for clock.Waiters() < n {
+		if time.Now().After(deadline) {
+			t.Fatalf("expected %d waiters, got %d", n, clock.Waiters())
+		}
+		time.Sleep(time.Millisecond)
+	}
+}
+
+func TestManualClockAdvance(t *testing.T) {
+	start := time.Date(2020, 1, 1, 12, 0, 0, 0, time.UTC)
+	clock := NewManualClock(start)
+
+	short := clock.After(time.Second)
+	long := clock.After(time.Minute)
+	if clock.Waiters() != 2 {
+		t.Fatalf("expected 2 waiters, got %d", clock.Waiters())
+	}
+
+	clock.Advance(10 * time.Second)
+	select {
+	case at := <-short:
+		if !at.Equal(start.Add(10 * time.Second)) {
+			t.Errorf("unexpected time %s", at)
+		}
+	default:
+		t.Fatal("expected the short wait to have fired")
+	}
+	select {
+	case <-long:
+		t.Fatal("expected the long wait to be pending")
+	default:
+	}
+	if clock.Waiters() != 1 {
+		t.Errorf("expected 1 waiter, got %d", clock.Waiters())
+	}
+
+	clock.Advance(time.Minute)
+	<-long
+
+	select {
+	case <-clock.After(0):
+	default:
+		t.Error("expected a zero wait to fire immediately")
+	}
+}
+
+func TestChannelRateLimiterManualClock(t *testing.T) {
+	clock := NewManualClock(time.Date(2020, 1, 1, 12, 0, 0, 0, time.UTC))
+	limiter := NewChannelRateLimiter(time.Second, 1)
+
+	if err := limiter.wait(context.Background(), "C1", clock); err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	done := make(chan error, 1)
+	go func() {
+		done <- limiter.wait(context.Background(), "C1", clock)
+	}()
+
+	waitForWaiters(t, clock, 1)
+	select {
+	case <-done:
+		t.Fatal("expected the second message to wait")
+	default:
+	}
+
+	clock.Advance(time.Second)
+	if err := <-done; err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+}
+
+func TestSchedulerManualClock(t *testing.T) {
+	clock := NewManualClock(time.Date(2020, 1, 1, 8, 30, 0, 0, time.UTC))
+	scheduler := NewScheduler(New("token", OptionClock(clock)))
+
+	ctx, cancel := context.WithCancel(context.Background())
+	runs := make(chan time.Time, 1)
+	scheduler.Schedule(ctx, time.UTC, Recurrence{Hour: 9}, func(ctx context.Context, at time.Time) {
+		runs <- at
+	})
+
+	waitForWaiters(t, clock, 1)
+	clock.Advance(30 * time.Minute)
+
+	if at := <-runs; !at.Equal(time.Date(2020, 1, 1, 9, 0, 0, 0, time.UTC)) {
+		t.Errorf("unexpected occurrence %s", at)
+	}
+
+	cancel()
+	scheduler.Wait()
+}
+
+func TestNewSecretsVerifierWithClock(t *testing.T) {
+	clock := NewManualClock(time.Unix(1531431954, 0))
+
+	sv, err := NewSecretsVerifierWithClock(newHeader(true), validSigningSecret, clock)
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	if _, err := sv.Write([]byte(validBody)); err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	if err := sv.Ensure(); err != nil {
+		t.Errorf("unexpected error: %s", err)
+	}
+
+	clock.Advance(10 * time.Minute)
+	if _, err := NewSecretsVerifierWithClock(newHeader(true), validSigningSecret, clock); err == nil {
+		t.Error("expected an error for the expired timestamp")
+	}
+}
@@ -0,0 +1,153 @@
+package slack
+
+import (
+	"context"
+	"strings"
+	"unicode/utf8"
+
+	"github.com/slack-go/slack/slackutilsx"
+)
+
+// Defaults of PostCodeParameters.
+const (
+	DefaultCodeChunkLength      = 3900
+	DefaultCodeSnippetThreshold = 4 * DefaultCodeChunkLength
+)
+
+const codeFence = "```"
+
+// PostCodeParameters contains the optional parameters of PostCode.
+type PostCodeParameters struct {
+	// Title is posted before the code, or used as the title of the snippet.
+	Title string
+	// Filetype is the type of the snippet, e.g. go or text, slack guesses it when empty.
+	Filetype string
+	// Filename is the name of the snippet.
+	Filename string
+	// ChunkLength is the maximum length of each message, DefaultCodeChunkLength when zero.
+	ChunkLength int
+	// SnippetThreshold is the length of code above which it is uploaded as a snippet rather than
+	// posted as messages, DefaultCodeSnippetThreshold when zero, negative to never upload snippets.
+	SnippetThreshold int
+	// ThreadTimestamp posts the code in a thread.
+	ThreadTimestamp string
+}
+
+// PostCode posts preformatted content, such as the logs of a CI job, to the channel. The content is
+// split at line boundaries across as many code blocks, each in its own message, as needed to stay
+// under the length of messages, or uploaded as a snippet above a size threshold.
+// It returns the timestamps of the messages posted, or the snippet uploaded.
+func (api *Client) PostCode(channelID, code string, params PostCodeParameters) ([]string, *File, error) {
+	return api.PostCodeContext(context.Background(), channelID, code, params)
+}
+
+// PostCodeContext posts preformatted content to the channel with a custom context, see PostCode.
+func (api *Client) PostCodeContext(ctx context.Context, channelID, code string, params PostCodeParameters) ([]string, *File, error) {
+	threshold := params.SnippetThreshold
+	if threshold == 0 {
+		threshold = DefaultCodeSnippetThreshold
+	}
+
+	if threshold > 0 && utf8.RuneCountInString(code) > threshold {
+		file, err := api.UploadFileContext(ctx, FileUploadParameters{
+			Content:         code,
+			Filetype:        params.Filetype,
+			Filename:        params.Filename,
+			Title:           params.Title,
+			Channels:        []string{channelID},
+			ThreadTimestamp: params.ThreadTimestamp,
+		})
+		return nil, file, err
+	}
+
+	chunks := SplitCodeBlock(code, params.ChunkLength)
+	if params.Title != "" && len(chunks) > 0 {
+		chunks[0] = slackutilsx.EscapeMessage(params.Title) + "\n" + chunks[0]
+	}
+
+	timestamps := make([]string, 0, len(chunks))
+	for _, chunk := range chunks {
+		options := []MsgOption{MsgOptionText(chunk, false)}
+		if params.ThreadTimestamp != "" {
+			options = append(options, MsgOptionTS(params.ThreadTimestamp))
+		}
+
+		_, timestamp, err := api.PostMessageContext(ctx, channelID, options...)
+		if err != nil {
+			return timestamps, nil, err
+		}
+		timestamps = append(timestamps, timestamp)
+	}
+
+	return timestamps, nil, nil
+}
+
+// SplitCodeBlock splits code into code blocks of at most maxLength characters, fences included,
+// DefaultCodeChunkLength when zero. Code is split at line boundaries, lines too long for a
+// block being split themselves. The code is escaped for mrkdwn, and fences it contains are broken
+// by a zero width space so they don't close the blocks.
+func SplitCodeBlock(code string, maxLength int) []string {
+	if maxLength <= 0 {
+		maxLength = DefaultCodeChunkLength
+	}
+	// the fences and newlines wrapping each block.
+	capacity := maxLength - 2*len(codeFence) - 2
+	if capacity < 1 {
+		capacity = 1
+	}
+
+	code = strings.Replace(strings.TrimRight(code, "\n"), codeFence, "`\u200b``", -1)
+
+	var (
+		chunks []string
+		chunk  strings.Builder
+		length int
+	)
+	flush := func() {
+		chunks = append(chunks, codeFence+"\n"+chunk.String()+"\n"+codeFence)
+		chunk.Reset()
+		length = 0
+	}
+
+	for _, line := range strings.Split(code, "\n") {
+		for _, part := range splitEscapedLine(line, capacity) {
+			partLength := utf8.RuneCountInString(part)
+			if length > 0 && length+1+partLength > capacity {
+				flush()
+			}
+			if length > 0 {
+				chunk.WriteByte('\n')
+				length++
+			}
+			chunk.WriteString(part)
+			length += partLength
+		}
+	}
+	flush()
+
+	return chunks
+}
+
+// splitEscapedLine escapes the line for mrkdwn and splits it in parts of at most capacity characters,
+// without cutting the escape sequences.
+func splitEscapedLine(line string, capacity int) []string {
+	var (
+		parts  []string
+		part   strings.Builder
+		length int
+	)
+
+	for _, r := range line {
+		escaped := slackutilsx.EscapeMessage(string(r))
+		escapedLength := utf8.RuneCountInString(escaped)
+		if length > 0 && length+escapedLength > capacity {
+			parts = append(parts, part.String())
+			part.Reset()
+			length = 0
+		}
+		part.WriteString(escaped)
+		length += escapedLength
+	}
+
+	return append(parts, part.String())
+}
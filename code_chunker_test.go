@@ -0,0 +1,81 @@
+package slack
+
+import (
+	"net/http"
+	"reflect"
+	"strings"
+	"testing"
+	"unicode/utf8"
+)
+
+func TestSplitCodeBlock(t *testing.T) {
+	for _, tt := range []struct {
+		name      string
+		code      string
+		maxLength int
+		want      []string
+	}{
+		{"single block", "line 1\nline 2\n", 100, []string{"```\nline 1\nline 2\n```"}},
+		{"split at lines", "line 1\nline 2\nline 3", 21, []string{"```\nline 1\nline 2\n```", "```\nline 3\n```"}},
+		{"long line", "0123456789abc", 16, []string{"```\n01234567\n```", "```\n89abc\n```"}},
+		{"escaped", "if a < b && c", 100, []string{"```\nif a &lt; b &amp;&amp; c\n```"}},
+		{"escape sequences kept whole", "a<b", 12, []string{"```\na\n```", "```\n&lt;\n```", "```\nb\n```"}},
+		{"inner fence", "```go\nx\n```", 100, []string{"```\n`\u200b``go\nx\n`\u200b``\n```"}},
+	} {
+		got := SplitCodeBlock(tt.code, tt.maxLength)
+		if !reflect.DeepEqual(got, tt.want) {
+			t.Errorf("%s: expected %q, got %q", tt.name, tt.want, got)
+		}
+		for _, chunk := range got {
+			if utf8.RuneCountInString(chunk) > tt.maxLength {
+				t.Errorf("%s: chunk %q exceeds %d characters", tt.name, chunk, tt.maxLength)
+			}
+		}
+	}
+}
+
+func TestPostCode(t *testing.T) {
+	http.DefaultServeMux = new(http.ServeMux)
+	defer func() { http.DefaultServeMux = new(http.ServeMux) }()
+
+	var (
+		texts   []string
+		uploads int
+	)
+	http.HandleFunc("/chat.postMessage", func(rw http.ResponseWriter, r *http.Request) {
+		texts = append(texts, r.FormValue("text"))
+		rw.Header().Set("Content-Type", "application/json")
+		rw.Write([]byte(`{"ok": true, "channel": "C1", "ts": "1234567890.00010` + string(rune('0'+len(texts))) + `"}`))
+	})
+	http.HandleFunc("/auth.test", func(rw http.ResponseWriter, r *http.Request) {
+		rw.Header().Set("Content-Type", "application/json")
+		rw.Write([]byte(`{"ok": true}`))
+	})
+	http.HandleFunc("/files.upload", func(rw http.ResponseWriter, r *http.Request) {
+		uploads++
+		rw.Header().Set("Content-Type", "application/json")
+		rw.Write([]byte(`{"ok": true, "file": {"id": "F1", "title": "` + r.FormValue("title") + `"}}`))
+	})
+	once.Do(startServer)
+	api := New("testing-token", OptionAPIURL("http://"+serverAddr+"/"))
+
+	code := strings.Repeat("ok\n", 10)
+	timestamps, file, err := api.PostCode("C1", code, PostCodeParameters{Title: "Build #1", ChunkLength: 20})
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	if file != nil || len(timestamps) != 3 || len(texts) != 3 {
+		t.Fatalf("expected 3 messages, got %v %v", timestamps, texts)
+	}
+	if !strings.HasPrefix(texts[0], "Build #1\n```\nok") || !strings.HasSuffix(texts[2], "ok\n```") {
+		t.Errorf("unexpected messages: %q", texts)
+	}
+
+	_, file, err = api.PostCode("C1", code, PostCodeParameters{Title: "Build #2", SnippetThreshold: 10})
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	if file == nil || file.Title != "Build #2" || uploads != 1 {
+		t.Errorf("expected a snippet, got %+v after %d uploads", file, uploads)
+	}
+}
@@ -2,9 +2,13 @@ package slack
 
 import (
 	"context"
+	"fmt"
 	"net/url"
+	"sort"
 	"strconv"
 	"strings"
+
+	"github.com/slack-go/slack/slackutilsx"
 )
 
 // Conversation is the foundation for IM and BaseGroupConversation
@@ -30,7 +34,12 @@ type Conversation struct {
 	Priority           float64  `json:"priority"`
 	User               string   `json:"user"`
 
-	// TODO support pending_shared
+	// Shared channels
+	SharedTeamIDs           []string `json:"shared_team_ids,omitempty"`
+	InternalTeamIDs         []string `json:"internal_team_ids,omitempty"`
+	PendingShared           []string `json:"pending_shared,omitempty"`
+	PendingConnectedTeamIDs []string `json:"pending_connected_team_ids,omitempty"`
+
 	// TODO support previous_names
 }
 
@@ -357,6 +366,116 @@ func (api *Client) CreateConversationContext(ctx context.Context, channelName st
 	return &response.Channel, nil
 }
 
+// CreateConversationParameters contains arguments for CreateConversationWithParameters method call
+type CreateConversationParameters struct {
+	ChannelName string
+	IsPrivate   bool
+	// TeamID is required when using an org token, it's the workspace in which the channel is created.
+	TeamID string
+}
+
+// CreateConversationWithParameters initiates a public or private channel-based conversation
+func (api *Client) CreateConversationWithParameters(params CreateConversationParameters) (*Channel, error) {
+	return api.CreateConversationWithParametersContext(context.Background(), params)
+}
+
+// CreateConversationWithParametersContext initiates a public or private channel-based conversation with a custom context
+func (api *Client) CreateConversationWithParametersContext(ctx context.Context, params CreateConversationParameters) (*Channel, error) {
+	values := url.Values{
+		"token":      {api.token},
+		"name":       {params.ChannelName},
+		"is_private": {strconv.FormatBool(params.IsPrivate)},
+	}
+	if params.TeamID != "" {
+		values.Add("team_id", params.TeamID)
+	}
+	response, err := api.channelRequest(ctx, "conversations.create", values)
+	if err != nil {
+		return nil, err
+	}
+
+	return &response.Channel, nil
+}
+
+// ChannelSetupParameters contains arguments for CreateChannelAndSetup method call
+type ChannelSetupParameters struct {
+	CreateConversationParameters
+	Topic   string
+	Purpose string
+	Users   []string
+}
+
+// ChannelSetupError is returned by CreateChannelAndSetup when the channel was created but couldn't be set up.
+type ChannelSetupError struct {
+	ChannelID string
+	Err       error
+	// RollbackErr is set when the channel couldn't be archived after the failure.
+	RollbackErr error
+}
+
+func (t ChannelSetupError) Error() string {
+	if t.RollbackErr != nil {
+		return fmt.Sprintf("failed to set up channel %s: %s, archiving it failed: %s", t.ChannelID, t.Err, t.RollbackErr)
+	}
+	return fmt.Sprintf("failed to set up channel %s: %s", t.ChannelID, t.Err)
+}
+
+// Unwrap returns the error which caused the setup to fail.
+func (t ChannelSetupError) Unwrap() error {
+	return t.Err
+}
+
+// CreateChannelAndSetup creates a channel, sets its topic and purpose and invites the users.
+// When any step after the creation fails the channel is archived and a ChannelSetupError is returned.
+func (api *Client) CreateChannelAndSetup(params ChannelSetupParameters) (*Channel, error) {
+	return api.CreateChannelAndSetupContext(context.Background(), params)
+}
+
+// CreateChannelAndSetupContext creates and sets up a channel with a custom context.
+// For more details, see CreateChannelAndSetup documentation.
+func (api *Client) CreateChannelAndSetupContext(ctx context.Context, params ChannelSetupParameters) (*Channel, error) {
+	channel, err := api.CreateConversationWithParametersContext(ctx, params.CreateConversationParameters)
+	if err != nil {
+		return nil, err
+	}
+
+	if err = api.setupChannel(ctx, channel, params); err != nil {
+		return nil, ChannelSetupError{
+			ChannelID:   channel.ID,
+			Err:         err,
+			RollbackErr: api.ArchiveConversationContext(ctx, channel.ID),
+		}
+	}
+
+	return channel, nil
+}
+
+func (api *Client) setupChannel(ctx context.Context, channel *Channel, params ChannelSetupParameters) error {
+	if params.Topic != "" {
+		updated, err := api.SetTopicOfConversationContext(ctx, channel.ID, params.Topic)
+		if err != nil {
+			return err
+		}
+		channel.Topic = updated.Topic
+	}
+
+	if params.Purpose != "" {
+		updated, err := api.SetPurposeOfConversationContext(ctx, channel.ID, params.Purpose)
+		if err != nil {
+			return err
+		}
+		channel.Purpose = updated.Purpose
+	}
+
+	if len(params.Users) > 0 {
+		if _, err := api.InviteUsersToConversationContext(ctx, channel.ID, params.Users...); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
 // GetConversationInfo retrieves information about a conversation
 func (api *Client) GetConversationInfo(channelID string, includeLocale bool) (*Channel, error) {
 	return api.GetConversationInfoContext(context.Background(), channelID, includeLocale)
@@ -618,3 +737,255 @@ func (api *Client) GetConversationHistoryContext(ctx context.Context, params *Ge
 
 	return &response, response.Err()
 }
+
+// ResolveConversationID converts a channel reference into a conversation ID.
+// References may be escaped channel links ("<#C1234|general>"), names
+// ("#general" or "general") or raw IDs. Names are looked up using
+// conversations.list, which requires the channels:read and groups:read scopes.
+func (api *Client) ResolveConversationID(ref string) (string, error) {
+	return api.ResolveConversationIDContext(context.Background(), ref)
+}
+
+// ResolveConversationIDContext converts a channel reference into a conversation ID with a custom context.
+// For more details, see ResolveConversationID documentation.
+func (api *Client) ResolveConversationIDContext(ctx context.Context, ref string) (string, error) {
+	id, name := slackutilsx.ParseChannelReference(ref)
+	if id != "" {
+		return id, nil
+	}
+
+	if name == "" {
+		return "", ErrParametersMissing
+	}
+
+	params := GetConversationsParameters{
+		Types: []string{"public_channel", "private_channel"},
+		Limit: 1000,
+	}
+	for {
+		channels, cursor, err := api.GetConversationsContext(ctx, &params)
+		if rateLimitedError, ok := err.(*RateLimitedError); ok {
+			select {
+			case <-ctx.Done():
+				return "", ctx.Err()
+			case <-api.currentClock().After(rateLimitedError.RetryAfter):
+				continue
+			}
+		}
+		if err != nil {
+			return "", err
+		}
+
+		for _, channel := range channels {
+			if channel.Name == name || channel.NameNormalized == name {
+				return channel.ID, nil
+			}
+		}
+
+		if cursor == "" {
+			return "", ErrConversationNotFound
+		}
+		params.Cursor = cursor
+	}
+}
+
+// MessageContext is a window of channel messages surrounding a target message.
+type MessageContext struct {
+	// Target is the message that was requested.
+	Target Message
+	// Parent is the thread parent of Target, set only when Target is a thread reply.
+	// The surrounding window is then taken around the parent.
+	Parent *Message
+	// Before holds up to the requested number of messages preceding the anchor, oldest first.
+	Before []Message
+	// After holds up to the requested number of messages following the anchor, oldest first.
+	After []Message
+}
+
+// GetMessageContext fetches the message at timestamp along with up to before
+// messages preceding it and up to after messages following it. When the
+// timestamp belongs to a thread reply the window is built around the thread parent.
+func (api *Client) GetMessageContext(channelID, timestamp string, before, after int) (*MessageContext, error) {
+	return api.GetMessageContextContext(context.Background(), channelID, timestamp, before, after)
+}
+
+// GetMessageContextContext fetches the messages surrounding a message with a custom context.
+// For more details, see GetMessageContext documentation.
+func (api *Client) GetMessageContextContext(ctx context.Context, channelID, timestamp string, before, after int) (*MessageContext, error) {
+	var (
+		err    error
+		result = &MessageContext{}
+		anchor *Message
+	)
+
+	if anchor, err = api.findChannelMessage(ctx, channelID, timestamp); err != nil {
+		return nil, err
+	}
+
+	if anchor != nil {
+		result.Target = *anchor
+	} else {
+		replies, _, _, err := api.GetConversationRepliesContext(ctx, &GetConversationRepliesParameters{
+			ChannelID: channelID,
+			Timestamp: timestamp,
+			Latest:    timestamp,
+			Oldest:    timestamp,
+			Inclusive: true,
+			Limit:     1,
+		})
+		if err != nil {
+			return nil, err
+		}
+
+		target := findMessage(replies, timestamp)
+		if target == nil || target.ThreadTimestamp == "" {
+			return nil, ErrMessageNotFound
+		}
+		result.Target = *target
+
+		if anchor, err = api.findChannelMessage(ctx, channelID, target.ThreadTimestamp); err != nil {
+			return nil, err
+		}
+		if anchor == nil {
+			return nil, ErrMessageNotFound
+		}
+		result.Parent = anchor
+	}
+
+	if before > 0 {
+		history, err := api.GetConversationHistoryContext(ctx, &GetConversationHistoryParameters{
+			ChannelID: channelID,
+			Latest:    anchor.Timestamp,
+			Limit:     before,
+		})
+		if err != nil {
+			return nil, err
+		}
+		result.Before = sortedMessages(history.Messages, before, true)
+	}
+
+	if after > 0 {
+		history, err := api.GetConversationHistoryContext(ctx, &GetConversationHistoryParameters{
+			ChannelID: channelID,
+			Oldest:    anchor.Timestamp,
+			Limit:     after,
+		})
+		if err != nil {
+			return nil, err
+		}
+		result.After = sortedMessages(history.Messages, after, false)
+	}
+
+	return result, nil
+}
+
+// findChannelMessage returns the top level channel message at timestamp, or nil if there isn't one.
+func (api *Client) findChannelMessage(ctx context.Context, channelID, timestamp string) (*Message, error) {
+	history, err := api.GetConversationHistoryContext(ctx, &GetConversationHistoryParameters{
+		ChannelID: channelID,
+		Latest:    timestamp,
+		Oldest:    timestamp,
+		Inclusive: true,
+		Limit:     1,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return findMessage(history.Messages, timestamp), nil
+}
+
+func findMessage(msgs []Message, timestamp string) *Message {
+	for i := range msgs {
+		if msgs[i].Timestamp == timestamp {
+			return &msgs[i]
+		}
+	}
+	return nil
+}
+
+// sortedMessages orders msgs oldest first and keeps at most n of them, either
+// the newest (keepLatest) or the oldest.
+func sortedMessages(msgs []Message, n int, keepLatest bool) []Message {
+	sort.Slice(msgs, func(i, j int) bool {
+		return msgs[i].Timestamp < msgs[j].Timestamp
+	})
+
+	if len(msgs) <= n {
+		return msgs
+	}
+
+	if keepLatest {
+		return msgs[len(msgs)-n:]
+	}
+	return msgs[:n]
+}
+
+// ConnectInvite is a pending or past invitation to a Slack Connect channel.
+type ConnectInvite struct {
+	Direction       string               `json:"direction"`
+	Status          string               `json:"status"`
+	DateLastUpdated JSONTime             `json:"date_last_updated"`
+	InviteType      string               `json:"invite_type"`
+	Invite          ConnectInviteDetails `json:"invite"`
+	Channel         ConnectInviteChannel `json:"channel"`
+}
+
+// ConnectInviteDetails describes who sent a Slack Connect invitation and to whom.
+type ConnectInviteDetails struct {
+	ID              string   `json:"id"`
+	DateCreated     JSONTime `json:"date_created"`
+	DateInvalid     JSONTime `json:"date_invalid"`
+	InvitingTeam    Team     `json:"inviting_team"`
+	InvitingUser    User     `json:"inviting_user"`
+	RecipientEmail  string   `json:"recipient_email,omitempty"`
+	RecipientUserID string   `json:"recipient_user_id,omitempty"`
+	Link            string   `json:"link,omitempty"`
+}
+
+// ConnectInviteChannel is the channel a Slack Connect invitation refers to.
+type ConnectInviteChannel struct {
+	ID        string `json:"id"`
+	Name      string `json:"name"`
+	IsPrivate bool   `json:"is_private"`
+	IsIM      bool   `json:"is_im"`
+}
+
+type ListConnectInvitesParameters struct {
+	Count  int
+	Cursor string
+	TeamID string
+}
+
+// ListConnectInvites lists the shared channel invites that have been generated or received but have not yet been approved by all parties
+func (api *Client) ListConnectInvites(params *ListConnectInvitesParameters) ([]ConnectInvite, string, error) {
+	return api.ListConnectInvitesContext(context.Background(), params)
+}
+
+// ListConnectInvitesContext lists the shared channel invites that have not yet been approved by all parties with a custom context
+func (api *Client) ListConnectInvitesContext(ctx context.Context, params *ListConnectInvitesParameters) ([]ConnectInvite, string, error) {
+	values := url.Values{
+		"token": {api.token},
+	}
+	if params.Count != 0 {
+		values.Add("count", strconv.Itoa(params.Count))
+	}
+	if params.Cursor != "" {
+		values.Add("cursor", params.Cursor)
+	}
+	if params.TeamID != "" {
+		values.Add("team_id", params.TeamID)
+	}
+	response := struct {
+		Invites          []ConnectInvite  `json:"invites"`
+		ResponseMetaData responseMetaData `json:"response_metadata"`
+		SlackResponse
+	}{}
+
+	err := api.postMethod(ctx, "conversations.listConnectInvites", values, &response)
+	if err != nil {
+		return nil, "", err
+	}
+
+	return response.Invites, response.ResponseMetaData.NextCursor, response.Err()
+}
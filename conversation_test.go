@@ -3,7 +3,9 @@ package slack
 import (
 	"encoding/json"
 	"net/http"
+	"net/url"
 	"reflect"
+	"strconv"
 	"testing"
 
 	"github.com/stretchr/testify/assert"
@@ -555,3 +557,287 @@ func TestGetConversationHistory(t *testing.T) {
 		return
 	}
 }
+
+func resolveConversationIDHandler(rw http.ResponseWriter, r *http.Request) {
+	rw.Header().Set("Content-Type", "application/json")
+	response := struct {
+		SlackResponse
+		ResponseMetaData responseMetaData `json:"response_metadata"`
+		Channels         []Channel        `json:"channels"`
+	}{
+		SlackResponse: SlackResponse{Ok: true},
+	}
+
+	channel := Channel{}
+	if r.FormValue("cursor") == "" {
+		channel.ID, channel.Name = "C1", "random"
+		response.ResponseMetaData.NextCursor = "page2"
+	} else {
+		channel.ID, channel.Name = "C2", "general"
+	}
+	response.Channels = []Channel{channel}
+
+	b, _ := json.Marshal(response)
+	rw.Write(b)
+}
+
+func TestResolveConversationID(t *testing.T) {
+	http.DefaultServeMux = new(http.ServeMux)
+	http.HandleFunc("/conversations.list", resolveConversationIDHandler)
+	once.Do(startServer)
+	api := New("testing-token", OptionAPIURL("http://"+serverAddr+"/"))
+
+	for ref, expected := range map[string]string{
+		"<#C3|other>": "C3",
+		"C4":          "C4",
+		"#random":     "C1",
+		"general":     "C2",
+	} {
+		id, err := api.ResolveConversationID(ref)
+		if err != nil {
+			t.Errorf("%s: unexpected error: %s", ref, err)
+			continue
+		}
+		if id != expected {
+			t.Errorf("%s: expected %s, got %s", ref, expected, id)
+		}
+	}
+
+	if _, err := api.ResolveConversationID("#missing"); err != ErrConversationNotFound {
+		t.Errorf("expected ErrConversationNotFound, got %v", err)
+	}
+}
+
+var messageContextChannel = []Message{
+	{Msg: Msg{Timestamp: "1500000001.000000", Text: "1"}},
+	{Msg: Msg{Timestamp: "1500000002.000000", Text: "2"}},
+	{Msg: Msg{Timestamp: "1500000003.000000", Text: "3", ThreadTimestamp: "1500000003.000000"}},
+	{Msg: Msg{Timestamp: "1500000004.000000", Text: "4"}},
+	{Msg: Msg{Timestamp: "1500000005.000000", Text: "5"}},
+}
+
+// filterMessages mimics conversations.history, returning messages newest first.
+// When only oldest is provided the messages closest to oldest are returned.
+func filterMessages(r *http.Request, msgs []Message) []Message {
+	latest, oldest := r.FormValue("latest"), r.FormValue("oldest")
+	inclusive := r.FormValue("inclusive") == "1"
+	limit, _ := strconv.Atoi(r.FormValue("limit"))
+
+	var result []Message
+	for i := len(msgs) - 1; i >= 0; i-- {
+		ts := msgs[i].Timestamp
+		if latest != "" && (ts > latest || (!inclusive && ts == latest)) {
+			continue
+		}
+		if oldest != "" && (ts < oldest || (!inclusive && ts == oldest)) {
+			continue
+		}
+		result = append(result, msgs[i])
+	}
+	if limit > 0 && len(result) > limit {
+		if latest == "" && oldest != "" {
+			return result[len(result)-limit:]
+		}
+		result = result[:limit]
+	}
+	return result
+}
+
+func messageContextHistoryHandler(rw http.ResponseWriter, r *http.Request) {
+	rw.Header().Set("Content-Type", "application/json")
+	response, _ := json.Marshal(GetConversationHistoryResponse{
+		SlackResponse: SlackResponse{Ok: true},
+		Messages:      filterMessages(r, messageContextChannel),
+	})
+	rw.Write(response)
+}
+
+func messageContextRepliesHandler(rw http.ResponseWriter, r *http.Request) {
+	rw.Header().Set("Content-Type", "application/json")
+	thread := []Message{
+		messageContextChannel[2],
+		{Msg: Msg{Timestamp: "1500000003.500000", Text: "reply", ThreadTimestamp: "1500000003.000000"}},
+	}
+	response, _ := json.Marshal(struct {
+		SlackResponse
+		Messages []Message `json:"messages"`
+	}{
+		SlackResponse: SlackResponse{Ok: true},
+		Messages:      filterMessages(r, thread),
+	})
+	rw.Write(response)
+}
+
+func TestGetMessageContext(t *testing.T) {
+	http.DefaultServeMux = new(http.ServeMux)
+	http.HandleFunc("/conversations.history", messageContextHistoryHandler)
+	http.HandleFunc("/conversations.replies", messageContextRepliesHandler)
+	once.Do(startServer)
+	api := New("testing-token", OptionAPIURL("http://"+serverAddr+"/"))
+
+	texts := func(msgs []Message) (s []string) {
+		for _, msg := range msgs {
+			s = append(s, msg.Text)
+		}
+		return s
+	}
+
+	mc, err := api.GetMessageContext("C1", "1500000003.000000", 1, 5)
+	if err != nil {
+		t.Fatalf("Unexpected error: %s", err)
+	}
+	assert.Equal(t, "3", mc.Target.Text)
+	assert.Nil(t, mc.Parent)
+	assert.Equal(t, []string{"2"}, texts(mc.Before))
+	assert.Equal(t, []string{"4", "5"}, texts(mc.After))
+
+	mc, err = api.GetMessageContext("C1", "1500000003.500000", 2, 1)
+	if err != nil {
+		t.Fatalf("Unexpected error: %s", err)
+	}
+	assert.Equal(t, "reply", mc.Target.Text)
+	if assert.NotNil(t, mc.Parent) {
+		assert.Equal(t, "3", mc.Parent.Text)
+	}
+	assert.Equal(t, []string{"1", "2"}, texts(mc.Before))
+	assert.Equal(t, []string{"4"}, texts(mc.After))
+
+	if _, err = api.GetMessageContext("C1", "1400000000.000000", 1, 1); err != ErrMessageNotFound {
+		t.Errorf("expected ErrMessageNotFound, got %v", err)
+	}
+}
+
+var sharedChannel = `{
+    "id": "C024BE91L",
+    "name": "fun",
+    "is_channel": true,
+    "is_ext_shared": true,
+    "is_org_shared": false,
+    "is_pending_ext_shared": true,
+    "shared_team_ids": ["T1", "T2"],
+    "internal_team_ids": ["T1"],
+    "pending_shared": ["T3"],
+    "pending_connected_team_ids": ["T3"]
+}`
+
+func TestSharedChannel(t *testing.T) {
+	channel, err := unmarshalChannel(sharedChannel)
+	assert.Nil(t, err)
+	assert.True(t, channel.IsExtShared)
+	assert.True(t, channel.IsPendingExtShared)
+	assert.Equal(t, []string{"T1", "T2"}, channel.SharedTeamIDs)
+	assert.Equal(t, []string{"T1"}, channel.InternalTeamIDs)
+	assert.Equal(t, []string{"T3"}, channel.PendingShared)
+	assert.Equal(t, []string{"T3"}, channel.PendingConnectedTeamIDs)
+}
+
+func listConnectInvitesHandler(rw http.ResponseWriter, r *http.Request) {
+	rw.Header().Set("Content-Type", "application/json")
+	rw.Write([]byte(`{
+		"ok": true,
+		"invites": [{
+			"direction": "outgoing",
+			"status": "pending",
+			"date_last_updated": 1592443800,
+			"invite_type": "channel",
+			"channel": {"id": "C1", "is_private": false, "is_im": false, "name": "shared"},
+			"invite": {
+				"id": "I1",
+				"date_created": 1592443800,
+				"date_invalid": 1593653400,
+				"inviting_team": {"id": "T1", "name": "Corp", "domain": "corp"},
+				"inviting_user": {"id": "U1", "team_id": "T1", "name": "bob"},
+				"recipient_email": "someone@example.com",
+				"link": "https://join.slack.com/share/I1"
+			}
+		}],
+		"response_metadata": {"next_cursor": "next"}
+	}`))
+}
+
+func TestListConnectInvites(t *testing.T) {
+	http.HandleFunc("/conversations.listConnectInvites", listConnectInvitesHandler)
+	once.Do(startServer)
+	api := New("testing-token", OptionAPIURL("http://"+serverAddr+"/"))
+
+	invites, cursor, err := api.ListConnectInvites(&ListConnectInvitesParameters{Count: 1})
+	if err != nil {
+		t.Fatalf("Unexpected error: %s", err)
+	}
+	assert.Equal(t, "next", cursor)
+	if assert.Len(t, invites, 1) {
+		assert.Equal(t, "pending", invites[0].Status)
+		assert.Equal(t, "C1", invites[0].Channel.ID)
+		assert.Equal(t, "I1", invites[0].Invite.ID)
+		assert.Equal(t, "T1", invites[0].Invite.InvitingTeam.ID)
+		assert.Equal(t, "U1", invites[0].Invite.InvitingUser.ID)
+		assert.Equal(t, "someone@example.com", invites[0].Invite.RecipientEmail)
+	}
+}
+
+func TestCreateChannelAndSetup(t *testing.T) {
+	http.DefaultServeMux = new(http.ServeMux)
+	defer func() { http.DefaultServeMux = new(http.ServeMux) }()
+
+	var (
+		created  url.Values
+		archived string
+		calls    []string
+	)
+	http.HandleFunc("/conversations.create", func(rw http.ResponseWriter, r *http.Request) {
+		r.ParseForm()
+		created = r.Form
+		okChannelJsonHandler(rw, r)
+	})
+	for _, method := range []string{"conversations.setTopic", "conversations.setPurpose"} {
+		method := method
+		http.HandleFunc("/"+method, func(rw http.ResponseWriter, r *http.Request) {
+			calls = append(calls, method)
+			okChannelJsonHandler(rw, r)
+		})
+	}
+	http.HandleFunc("/conversations.invite", func(rw http.ResponseWriter, r *http.Request) {
+		calls = append(calls, "conversations.invite")
+		rw.Header().Set("Content-Type", "application/json")
+		rw.Write([]byte(`{"ok": false, "error": "cant_invite"}`))
+	})
+	http.HandleFunc("/conversations.archive", func(rw http.ResponseWriter, r *http.Request) {
+		archived = r.FormValue("channel")
+		rw.Header().Set("Content-Type", "application/json")
+		rw.Write([]byte(`{"ok": true}`))
+	})
+
+	once.Do(startServer)
+	api := New("testing-token", OptionAPIURL("http://"+serverAddr+"/"))
+
+	params := ChannelSetupParameters{
+		CreateConversationParameters: CreateConversationParameters{ChannelName: "incident-42", IsPrivate: true, TeamID: "T1"},
+		Topic:                        "Outage",
+		Purpose:                      "Coordinate the response",
+	}
+	if _, err := api.CreateChannelAndSetup(params); err != nil {
+		t.Fatalf("Unexpected error: %s", err)
+	}
+	if created.Get("is_private") != "true" || created.Get("team_id") != "T1" || created.Get("name") != "incident-42" {
+		t.Errorf("unexpected create parameters: %v", created)
+	}
+	if !reflect.DeepEqual(calls, []string{"conversations.setTopic", "conversations.setPurpose"}) {
+		t.Errorf("unexpected calls: %v", calls)
+	}
+	if archived != "" {
+		t.Errorf("channel should not have been archived")
+	}
+
+	params.Users = []string{"U1"}
+	_, err := api.CreateChannelAndSetup(params)
+	setupErr, ok := err.(ChannelSetupError)
+	if !ok {
+		t.Fatalf("expected a ChannelSetupError, got %#v", err)
+	}
+	if setupErr.Err.Error() != "cant_invite" || setupErr.RollbackErr != nil {
+		t.Errorf("unexpected error: %s", setupErr)
+	}
+	if archived != setupErr.ChannelID {
+		t.Errorf("expected channel %s to be archived, got %q", setupErr.ChannelID, archived)
+	}
+}
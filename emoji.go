@@ -3,13 +3,43 @@ package slack
 import (
 	"context"
 	"net/url"
+	"sort"
+	"strings"
 )
 
+const emojiAliasPrefix = "alias:"
+
 type emojiResponseFull struct {
 	Emoji map[string]string `json:"emoji"`
 	SlackResponse
 }
 
+// Emoji is a custom emoji of the team, along with its metadata when listed with the
+// admin metadata of an Enterprise Grid organization.
+type Emoji struct {
+	Name string `json:"name"`
+	// URL is the image of the emoji, it's empty for aliases.
+	URL string `json:"url,omitempty"`
+	// AliasFor is the name of the emoji an alias refers to.
+	AliasFor    string   `json:"alias_for,omitempty"`
+	UploadedBy  string   `json:"uploaded_by,omitempty"`
+	DateCreated JSONTime `json:"date_created,omitempty"`
+}
+
+// IsAlias reports whether the emoji is an alias of another emoji.
+func (e Emoji) IsAlias() bool {
+	return e.AliasFor != ""
+}
+
+// ListAllEmojiParameters contains arguments for ListAllEmoji method call
+type ListAllEmojiParameters struct {
+	// IncludeAdminMetadata merges the uploader and creation date of the emoji, paging through
+	// admin.emoji.list. It requires an admin token of an Enterprise Grid organization.
+	IncludeAdminMetadata bool
+	// Limit is the size of the pages of admin.emoji.list, the API default when zero.
+	Limit int
+}
+
 // GetEmoji retrieves all the emojis
 func (api *Client) GetEmoji() (map[string]string, error) {
 	return api.GetEmojiContext(context.Background())
@@ -33,3 +63,73 @@ func (api *Client) GetEmojiContext(ctx context.Context) (map[string]string, erro
 
 	return response.Emoji, nil
 }
+
+// ListAllEmoji retrieves all the custom emoji of the team as typed emoji, sorted by name.
+func (api *Client) ListAllEmoji(params ListAllEmojiParameters) ([]Emoji, error) {
+	return api.ListAllEmojiContext(context.Background(), params)
+}
+
+// ListAllEmojiContext retrieves all the custom emoji of the team with a custom context
+func (api *Client) ListAllEmojiContext(ctx context.Context, params ListAllEmojiParameters) ([]Emoji, error) {
+	list, err := api.GetEmojiContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	emoji := make(map[string]*Emoji, len(list))
+	for name, value := range list {
+		e := newEmoji(name, value)
+		emoji[name] = &e
+	}
+
+	if params.IncludeAdminMetadata {
+		cursor := ""
+		for {
+			var (
+				page map[string]AdminEmoji
+				next string
+			)
+			err := retryRateLimited(ctx, api.currentClock(), func() (err error) {
+				page, next, err = api.ListAdminEmojiContext(ctx, cursor, params.Limit)
+				return err
+			})
+			if err != nil {
+				return nil, err
+			}
+
+			for name, admin := range page {
+				e, ok := emoji[name]
+				if !ok {
+					created := newEmoji(name, admin.URL)
+					e = &created
+					emoji[name] = e
+				}
+				e.UploadedBy = admin.UploadedBy
+				e.DateCreated = admin.DateCreated
+			}
+
+			if next == "" {
+				break
+			}
+			cursor = next
+		}
+	}
+
+	all := make([]Emoji, 0, len(emoji))
+	for _, e := range emoji {
+		all = append(all, *e)
+	}
+	sort.Slice(all, func(i, j int) bool {
+		return all[i].Name < all[j].Name
+	})
+	return all, nil
+}
+
+// newEmoji creates an emoji from a value of emoji.list, either the URL of an image or the
+// name of the aliased emoji prefixed with "alias:".
+func newEmoji(name, value string) Emoji {
+	if strings.HasPrefix(value, emojiAliasPrefix) {
+		return Emoji{Name: name, AliasFor: strings.TrimPrefix(value, emojiAliasPrefix)}
+	}
+	return Emoji{Name: name, URL: value}
+}
@@ -37,3 +37,55 @@ func TestGetEmoji(t *testing.T) {
 		t.Errorf("got %v; want %v", emojis, emojisResponse)
 	}
 }
+
+func TestListAllEmoji(t *testing.T) {
+	http.DefaultServeMux = new(http.ServeMux)
+	defer func() { http.DefaultServeMux = new(http.ServeMux) }()
+
+	http.HandleFunc("/emoji.list", getEmojiHandler)
+	http.HandleFunc("/admin.emoji.list", func(rw http.ResponseWriter, r *http.Request) {
+		rw.Header().Set("Content-Type", "application/json")
+		if r.FormValue("cursor") == "" {
+			rw.Write([]byte(`{"ok": true, "emoji": {
+				"squirrel": {"url": "https://my.slack.com/emoji/squirrel/f35f40c0e0.png", "date_created": 1574370021, "uploaded_by": "W1"}
+			}, "response_metadata": {"next_cursor": "page2"}}`))
+			return
+		}
+		rw.Write([]byte(`{"ok": true, "emoji": {
+			"party": {"url": "https://emoji.slack-edge.com/party.png", "date_created": 1574370022, "uploaded_by": "W2"}
+		}, "response_metadata": {"next_cursor": ""}}`))
+	})
+
+	once.Do(startServer)
+	api := New("testing-token", OptionAPIURL("http://"+serverAddr+"/"))
+
+	emoji, err := api.ListAllEmoji(ListAllEmojiParameters{})
+	if err != nil {
+		t.Fatalf("Unexpected error: %s", err)
+	}
+	expected := []Emoji{
+		{Name: "bowtie", URL: "https://my.slack.com/emoji/bowtie/46ec6f2bb0.png"},
+		{Name: "shipit", AliasFor: "squirrel"},
+		{Name: "squirrel", URL: "https://my.slack.com/emoji/squirrel/f35f40c0e0.png"},
+	}
+	if !reflect.DeepEqual(emoji, expected) {
+		t.Errorf("got %#v; want %#v", emoji, expected)
+	}
+	if !emoji[1].IsAlias() || emoji[0].IsAlias() {
+		t.Error("Expected only shipit to be an alias")
+	}
+
+	emoji, err = api.ListAllEmoji(ListAllEmojiParameters{IncludeAdminMetadata: true})
+	if err != nil {
+		t.Fatalf("Unexpected error: %s", err)
+	}
+	expected = []Emoji{
+		{Name: "bowtie", URL: "https://my.slack.com/emoji/bowtie/46ec6f2bb0.png"},
+		{Name: "party", URL: "https://emoji.slack-edge.com/party.png", UploadedBy: "W2", DateCreated: 1574370022},
+		{Name: "shipit", AliasFor: "squirrel"},
+		{Name: "squirrel", URL: "https://my.slack.com/emoji/squirrel/f35f40c0e0.png", UploadedBy: "W1", DateCreated: 1574370021},
+	}
+	if !reflect.DeepEqual(emoji, expected) {
+		t.Errorf("got %#v; want %#v", emoji, expected)
+	}
+}
@@ -8,10 +8,24 @@ const (
 	ErrRTMDisconnected      = errorsx.String("disconnect received while trying to connect")
 	ErrRTMGoodbye           = errorsx.String("goodbye detected")
 	ErrRTMDeadman           = errorsx.String("deadman switch triggered")
+	ErrRTMClosed            = errorsx.String("rtm connection closed")
 	ErrParametersMissing    = errorsx.String("received empty parameters")
 	ErrInvalidConfiguration = errorsx.String("invalid configuration")
 	ErrMissingHeaders       = errorsx.String("missing headers")
 	ErrExpiredTimestamp     = errorsx.String("timestamp is too old")
+	ErrConversationNotFound = errorsx.String("conversation not found")
+	ErrMessageNotFound      = errorsx.String("message not found")
+	ErrFileTombstoned       = errorsx.String("file has been deleted")
+	ErrFileHiddenByLimit    = errorsx.String("file is hidden by the workspace storage limit")
+	ErrFileNotPublic        = errorsx.String("file has not been shared publicly")
+	ErrValueTooLarge        = errorsx.String("encoded value exceeds the size limit")
+	ErrValueMalformed       = errorsx.String("encoded value is malformed")
+	ErrValueSignature       = errorsx.String("encoded value has an invalid signature")
+	ErrUpdateConflict       = errorsx.String("message was modified concurrently")
+	ErrBroadcastMention     = errorsx.String("message contains a broadcast mention")
+	ErrInvalidURL           = errorsx.String("value is not an http or https URL")
+	ErrResponseURLExpired   = errorsx.String("response url has expired")
+	ErrResponseURLExhausted = errorsx.String("response url accepts no more responses")
 )
 
 // internal errors
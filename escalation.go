@@ -0,0 +1,271 @@
+package slack
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"time"
+)
+
+const escalationActionAck = "ack"
+
+// EscalationEventType is the kind of change reported by an Escalation.
+type EscalationEventType string
+
+const (
+	EscalationTriggered    EscalationEventType = "triggered"
+	EscalationAcknowledged EscalationEventType = "acknowledged"
+	EscalationEscalated    EscalationEventType = "escalated"
+)
+
+// EscalationConfig describes where alerts are posted and escalated.
+type EscalationConfig struct {
+	// CallbackID identifies the interactions belonging to this escalation, it must be unique within the app.
+	CallbackID string
+	// Channel is where alerts are posted.
+	Channel string
+	// EscalationChannel is notified of the alerts which weren't acknowledged within Timeout.
+	// Alerts are posted again to Channel when it's empty.
+	EscalationChannel string
+	// Timeout is how long an alert may stay unacknowledged, alerts are never escalated when it's zero.
+	Timeout time.Duration
+	// OnEvent is called for every change of an alert, e.g. to open or resolve an incident of a
+	// paging system. It's optional and must not block.
+	OnEvent func(EscalationEvent)
+}
+
+// EscalationAlert is the state of an alert posted by an Escalation.
+type EscalationAlert struct {
+	// ID is the timestamp of the alert message.
+	ID          string
+	Text        string
+	TriggeredAt time.Time
+	// EscalationChannel and EscalationTimestamp identify the escalation message, once escalated.
+	EscalationChannel   string
+	EscalationTimestamp string
+	EscalatedAt         time.Time
+	AcknowledgedBy      string
+	AcknowledgedAt      time.Time
+}
+
+// Acknowledged reports whether the alert was acknowledged.
+func (a EscalationAlert) Acknowledged() bool {
+	return a.AcknowledgedBy != ""
+}
+
+// EscalationEvent reports a change of an alert.
+type EscalationEvent struct {
+	Type  EscalationEventType
+	Alert EscalationAlert
+}
+
+// Escalation posts alerts with an acknowledge button, and escalates the alerts nobody
+// acknowledged in time. The resulting block_actions interactions must be passed to
+// HandleInteraction.
+type Escalation struct {
+	client *Client
+	config EscalationConfig
+	wg     sync.WaitGroup
+	// ctx bounds the pending escalations, it's cancelled by Stop.
+	ctx    context.Context
+	cancel context.CancelFunc
+
+	mu     sync.Mutex
+	alerts map[string]*EscalationAlert
+}
+
+// NewEscalation creates an escalation using the provided configuration.
+func NewEscalation(client *Client, config EscalationConfig) *Escalation {
+	ctx, cancel := context.WithCancel(context.Background())
+	return &Escalation{
+		client: client,
+		config: config,
+		ctx:    ctx,
+		cancel: cancel,
+		alerts: map[string]*EscalationAlert{},
+	}
+}
+
+// Trigger posts an alert to the channel and escalates it once the timeout elapsed, unless
+// it's acknowledged before or the escalation is stopped. ctx only applies to posting the alert,
+// so Trigger can be called from a handler whose context ends with the request.
+func (e *Escalation) Trigger(ctx context.Context, text string) (EscalationAlert, error) {
+	_, ts, err := e.client.PostMessageContext(
+		ctx,
+		e.config.Channel,
+		MsgOptionText(text, false),
+		MsgOptionBlocks(e.alertBlocks(text, "")...),
+	)
+	if err != nil {
+		return EscalationAlert{}, err
+	}
+
+	alert := &EscalationAlert{
+		ID:          ts,
+		Text:        text,
+		TriggeredAt: e.client.currentClock().Now(),
+	}
+	e.mu.Lock()
+	e.alerts[ts] = alert
+	triggered := *alert
+	e.mu.Unlock()
+	e.notify(EscalationTriggered, triggered)
+
+	if e.config.Timeout > 0 {
+		e.wg.Add(1)
+		go func() {
+			defer e.wg.Done()
+
+			select {
+			case <-e.ctx.Done():
+				return
+			case <-e.client.currentClock().After(e.config.Timeout):
+			}
+
+			if err := e.escalate(e.ctx, ts); err != nil {
+				e.client.Debugf("escalation %s: failed to escalate %s: %v", e.config.CallbackID, ts, err)
+			}
+		}()
+	}
+
+	return triggered, nil
+}
+
+// HandleInteraction acknowledges the alert whose button was clicked, replacing the button of its
+// messages with the name of the user. It reports whether the callback belonged to this escalation.
+func (e *Escalation) HandleInteraction(ctx context.Context, callback *InteractionCallback) (bool, error) {
+	if callback.Type != InteractionTypeBlockActions {
+		return false, nil
+	}
+
+	for _, action := range callback.ActionCallback.BlockActions {
+		if action.BlockID != e.config.CallbackID || action.ActionID != escalationActionAck {
+			continue
+		}
+
+		// the button of the alert message has no value, it's identified by the message.
+		id := action.Value
+		if id == "" {
+			id = callback.Container.MessageTs
+		}
+		return true, e.acknowledge(ctx, id, callback.User.ID)
+	}
+
+	return false, nil
+}
+
+// Alert returns the state of the alert with the provided ID.
+func (e *Escalation) Alert(id string) (EscalationAlert, bool) {
+	e.mu.Lock()
+	defer e.mu.Unlock()
+
+	alert, ok := e.alerts[id]
+	if !ok {
+		return EscalationAlert{}, false
+	}
+	return *alert, true
+}
+
+// Wait blocks until all pending escalations have stopped.
+func (e *Escalation) Wait() {
+	e.wg.Wait()
+}
+
+// Stop cancels the pending escalations and waits for them to stop.
+func (e *Escalation) Stop() {
+	e.cancel()
+	e.wg.Wait()
+}
+
+func (e *Escalation) escalate(ctx context.Context, id string) error {
+	e.mu.Lock()
+	alert := e.alerts[id]
+	if alert.Acknowledged() {
+		e.mu.Unlock()
+		return nil
+	}
+	text := alert.Text
+	e.mu.Unlock()
+
+	channel := e.config.EscalationChannel
+	if channel == "" {
+		channel = e.config.Channel
+	}
+	text = fmt.Sprintf("Not acknowledged after %s: %s", e.config.Timeout, text)
+	channel, ts, err := e.client.PostMessageContext(
+		ctx,
+		channel,
+		MsgOptionText(text, false),
+		MsgOptionBlocks(e.alertBlocks(text, id)...),
+	)
+	if err != nil {
+		return err
+	}
+
+	e.mu.Lock()
+	alert.EscalationChannel = channel
+	alert.EscalationTimestamp = ts
+	if alert.Acknowledged() {
+		// acknowledged while the escalation message was posted, too early to update it as well.
+		acknowledged := *alert
+		e.mu.Unlock()
+		_, _, _, err = e.client.UpdateMessageContext(ctx, channel, ts, MsgOptionText(acknowledged.Text, false), MsgOptionBlocks(e.acknowledgedBlocks(acknowledged)...))
+		return err
+	}
+	alert.EscalatedAt = e.client.currentClock().Now()
+	escalated := *alert
+	e.mu.Unlock()
+	e.notify(EscalationEscalated, escalated)
+
+	return nil
+}
+
+func (e *Escalation) acknowledge(ctx context.Context, id, userID string) error {
+	e.mu.Lock()
+	alert, ok := e.alerts[id]
+	if !ok || alert.Acknowledged() {
+		e.mu.Unlock()
+		return nil
+	}
+	alert.AcknowledgedBy = userID
+	alert.AcknowledgedAt = e.client.currentClock().Now()
+	acknowledged := *alert
+	e.mu.Unlock()
+	e.notify(EscalationAcknowledged, acknowledged)
+
+	blocks := e.acknowledgedBlocks(acknowledged)
+	if _, _, _, err := e.client.UpdateMessageContext(ctx, e.config.Channel, acknowledged.ID, MsgOptionText(acknowledged.Text, false), MsgOptionBlocks(blocks...)); err != nil {
+		return err
+	}
+	if acknowledged.EscalationTimestamp != "" {
+		if _, _, _, err := e.client.UpdateMessageContext(ctx, acknowledged.EscalationChannel, acknowledged.EscalationTimestamp, MsgOptionText(acknowledged.Text, false), MsgOptionBlocks(blocks...)); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// acknowledgedBlocks builds the blocks replacing those of the messages of an acknowledged alert.
+func (e *Escalation) acknowledgedBlocks(alert EscalationAlert) []Block {
+	return []Block{
+		NewSectionBlock(NewTextBlockObject(MarkdownType, alert.Text, false, false), nil, nil),
+		NewContextBlock("", NewTextBlockObject(MarkdownType, fmt.Sprintf("Acknowledged by <@%s>", alert.AcknowledgedBy), false, false)),
+	}
+}
+
+// alertBlocks builds the blocks of an alert message, value identifies the alert from the
+// messages other than the alert message.
+func (e *Escalation) alertBlocks(text, value string) []Block {
+	button := NewButtonBlockElement(escalationActionAck, value, NewTextBlockObject(PlainTextType, "Acknowledge", false, false))
+	return []Block{
+		NewSectionBlock(NewTextBlockObject(MarkdownType, text, false, false), nil, nil),
+		NewActionBlock(e.config.CallbackID, button.WithStyle(StylePrimary)),
+	}
+}
+
+func (e *Escalation) notify(eventType EscalationEventType, alert EscalationAlert) {
+	if e.config.OnEvent != nil {
+		e.config.OnEvent(EscalationEvent{Type: eventType, Alert: alert})
+	}
+}
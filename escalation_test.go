@@ -0,0 +1,196 @@
+package slack
+
+import (
+	"context"
+	"net/http"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestEscalation(t *testing.T) {
+	http.DefaultServeMux = new(http.ServeMux)
+	defer func() { http.DefaultServeMux = new(http.ServeMux) }()
+
+	var (
+		mu      sync.Mutex
+		posts   int
+		posted  = make(chan string, 10)
+		updated = make(chan string, 10)
+	)
+	http.HandleFunc("/chat.postMessage", func(rw http.ResponseWriter, r *http.Request) {
+		mu.Lock()
+		posts++
+		ts := "150000000" + string(rune('0'+posts)) + ".000000"
+		mu.Unlock()
+		posted <- r.FormValue("channel") + " " + r.FormValue("text")
+		rw.Header().Set("Content-Type", "application/json")
+		rw.Write([]byte(`{"ok": true, "channel": "` + r.FormValue("channel") + `", "ts": "` + ts + `"}`))
+	})
+	http.HandleFunc("/chat.update", func(rw http.ResponseWriter, r *http.Request) {
+		updated <- r.FormValue("channel") + " " + r.FormValue("ts")
+		rw.Header().Set("Content-Type", "application/json")
+		rw.Write([]byte(`{"ok": true, "channel": "` + r.FormValue("channel") + `", "ts": "` + r.FormValue("ts") + `"}`))
+	})
+	once.Do(startServer)
+
+	clock := NewManualClock(time.Date(2020, 1, 1, 12, 0, 0, 0, time.UTC))
+	api := New(validToken, OptionAPIURL("http://"+serverAddr+"/"), OptionClock(clock))
+
+	var events []EscalationEventType
+	escalation := NewEscalation(api, EscalationConfig{
+		CallbackID:        "db-alerts",
+		Channel:           "C1",
+		EscalationChannel: "C2",
+		Timeout:           5 * time.Minute,
+		OnEvent: func(event EscalationEvent) {
+			mu.Lock()
+			events = append(events, event.Type)
+			mu.Unlock()
+		},
+	})
+	ctx := context.Background()
+
+	// the first alert is acknowledged in time.
+	first, err := escalation.Trigger(ctx, "disk full")
+	assert.NoError(t, err)
+	assert.Equal(t, "C1 disk full", <-posted)
+
+	handled, err := escalation.HandleInteraction(ctx, &InteractionCallback{
+		Type:      InteractionTypeBlockActions,
+		User:      User{ID: "U1"},
+		Container: Container{ChannelID: "C1", MessageTs: first.ID},
+		ActionCallback: ActionCallbacks{
+			BlockActions: []*BlockAction{{BlockID: "db-alerts", ActionID: "ack"}},
+		},
+	})
+	assert.True(t, handled)
+	assert.NoError(t, err)
+	assert.Equal(t, "C1 "+first.ID, <-updated)
+
+	// the second alert is escalated, then acknowledged from the escalation message.
+	second, err := escalation.Trigger(ctx, "replica lagging")
+	assert.NoError(t, err)
+	assert.Equal(t, "C1 replica lagging", <-posted)
+
+	waitForWaiters(t, clock, 2)
+	clock.Advance(5 * time.Minute)
+	assert.Equal(t, "C2 Not acknowledged after 5m0s: replica lagging", <-posted)
+	escalation.Wait()
+
+	alert, ok := escalation.Alert(second.ID)
+	assert.True(t, ok)
+	assert.Equal(t, "C2", alert.EscalationChannel)
+	assert.False(t, alert.Acknowledged())
+
+	handled, err = escalation.HandleInteraction(ctx, &InteractionCallback{
+		Type:      InteractionTypeBlockActions,
+		User:      User{ID: "U2"},
+		Container: Container{ChannelID: "C2", MessageTs: alert.EscalationTimestamp},
+		ActionCallback: ActionCallbacks{
+			BlockActions: []*BlockAction{{BlockID: "db-alerts", ActionID: "ack", Value: second.ID}},
+		},
+	})
+	assert.True(t, handled)
+	assert.NoError(t, err)
+	assert.Equal(t, "C1 "+second.ID, <-updated)
+	assert.Equal(t, "C2 "+alert.EscalationTimestamp, <-updated)
+
+	alert, _ = escalation.Alert(second.ID)
+	assert.Equal(t, "U2", alert.AcknowledgedBy)
+
+	handled, _ = escalation.HandleInteraction(ctx, &InteractionCallback{
+		Type: InteractionTypeBlockActions,
+		ActionCallback: ActionCallbacks{
+			BlockActions: []*BlockAction{{BlockID: "other", ActionID: "ack"}},
+		},
+	})
+	assert.False(t, handled)
+
+	assert.Equal(t, []EscalationEventType{
+		EscalationTriggered, EscalationAcknowledged,
+		EscalationTriggered, EscalationEscalated, EscalationAcknowledged,
+	}, events)
+}
+
+func TestEscalationAcknowledgedWhileEscalating(t *testing.T) {
+	http.DefaultServeMux = new(http.ServeMux)
+	defer func() { http.DefaultServeMux = new(http.ServeMux) }()
+
+	var (
+		posted  = make(chan string, 10)
+		updated = make(chan string, 10)
+		release = make(chan struct{})
+	)
+	http.HandleFunc("/chat.postMessage", func(rw http.ResponseWriter, r *http.Request) {
+		channel := r.FormValue("channel")
+		posted <- channel
+		ts := "1500000001.000000"
+		if channel == "C2" {
+			// the escalation message is posted once the alert was acknowledged.
+			<-release
+			ts = "1500000002.000000"
+		}
+		rw.Header().Set("Content-Type", "application/json")
+		rw.Write([]byte(`{"ok": true, "channel": "` + channel + `", "ts": "` + ts + `"}`))
+	})
+	http.HandleFunc("/chat.update", func(rw http.ResponseWriter, r *http.Request) {
+		updated <- r.FormValue("channel") + " " + r.FormValue("ts")
+		rw.Header().Set("Content-Type", "application/json")
+		rw.Write([]byte(`{"ok": true, "channel": "` + r.FormValue("channel") + `", "ts": "` + r.FormValue("ts") + `"}`))
+	})
+	once.Do(startServer)
+
+	clock := NewManualClock(time.Date(2020, 1, 1, 12, 0, 0, 0, time.UTC))
+	api := New(validToken, OptionAPIURL("http://"+serverAddr+"/"), OptionClock(clock))
+
+	var (
+		mu     sync.Mutex
+		events []EscalationEventType
+	)
+	escalation := NewEscalation(api, EscalationConfig{
+		CallbackID:        "db-alerts",
+		Channel:           "C1",
+		EscalationChannel: "C2",
+		Timeout:           5 * time.Minute,
+		OnEvent: func(event EscalationEvent) {
+			mu.Lock()
+			events = append(events, event.Type)
+			mu.Unlock()
+		},
+	})
+	defer escalation.Stop()
+
+	// the context of the trigger ends with it, e.g. that of an http handler.
+	ctx, cancel := context.WithCancel(context.Background())
+	alert, err := escalation.Trigger(ctx, "disk full")
+	cancel()
+	assert.NoError(t, err)
+	assert.Equal(t, "C1", <-posted)
+
+	waitForWaiters(t, clock, 1)
+	clock.Advance(5 * time.Minute)
+	assert.Equal(t, "C2", <-posted)
+
+	handled, err := escalation.HandleInteraction(context.Background(), &InteractionCallback{
+		Type:      InteractionTypeBlockActions,
+		User:      User{ID: "U1"},
+		Container: Container{ChannelID: "C1", MessageTs: alert.ID},
+		ActionCallback: ActionCallbacks{
+			BlockActions: []*BlockAction{{BlockID: "db-alerts", ActionID: "ack"}},
+		},
+	})
+	assert.True(t, handled)
+	assert.NoError(t, err)
+	assert.Equal(t, "C1 "+alert.ID, <-updated)
+
+	close(release)
+	escalation.Wait()
+	assert.Equal(t, "C2 1500000002.000000", <-updated)
+
+	mu.Lock()
+	defer mu.Unlock()
+	assert.Equal(t, []EscalationEventType{EscalationTriggered, EscalationAcknowledged}, events)
+}
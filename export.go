@@ -0,0 +1,309 @@
+package slack
+
+import (
+	"archive/zip"
+	"encoding/json"
+	"io"
+	"io/ioutil"
+	"os"
+	"path"
+	"path/filepath"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+)
+
+// Files of a workspace export listing the users and conversations.
+const (
+	ExportUsersFile    = "users.json"
+	ExportChannelsFile = "channels.json"
+	ExportGroupsFile   = "groups.json"
+	ExportMPIMsFile    = "mpims.json"
+	ExportDMsFile      = "dms.json"
+)
+
+// ExportWriter writes fetched data using the layout of the workspace exports made by slack:
+// users and conversations are listed in users.json, channels.json, groups.json, mpims.json
+// and dms.json, and messages are stored in one file per day, e.g. general/2020-06-01.json,
+// in a directory named after the conversation, or its ID for direct messages.
+type ExportWriter struct {
+	dir string
+
+	mu    sync.Mutex
+	names map[string]string
+}
+
+// NewExportWriter creates a writer storing the export in dir, which is created when missing.
+func NewExportWriter(dir string) *ExportWriter {
+	return &ExportWriter{dir: dir, names: map[string]string{}}
+}
+
+// WriteUsers writes users.json.
+func (w *ExportWriter) WriteUsers(users []User) error {
+	return w.writeJSON(filepath.Join(w.dir, ExportUsersFile), users)
+}
+
+// WriteChannels writes the conversations to the list matching their type, public channels to channels.json,
+// private channels to groups.json, multiparty direct messages to mpims.json and direct messages to dms.json.
+// It also records the directory used for the messages of each conversation, so it must be called before WriteMessages.
+func (w *ExportWriter) WriteChannels(channels []Channel) error {
+	lists := map[string][]Channel{
+		ExportChannelsFile: {},
+		ExportGroupsFile:   {},
+		ExportMPIMsFile:    {},
+		ExportDMsFile:      {},
+	}
+
+	w.mu.Lock()
+	for _, channel := range channels {
+		file := exportListFile(channel)
+		lists[file] = append(lists[file], channel)
+
+		if channel.IsIM || channel.Name == "" {
+			w.names[channel.ID] = channel.ID
+		} else {
+			w.names[channel.ID] = channel.Name
+		}
+	}
+	w.mu.Unlock()
+
+	for file, list := range lists {
+		if err := w.writeJSON(filepath.Join(w.dir, file), list); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// WriteMessages adds the messages of the conversation to the per day files, days are in UTC.
+// Messages are merged with the content of existing files, replacing those with the same timestamp,
+// so history can be written one page at a time, e.g. from a BackfillHandler.
+func (w *ExportWriter) WriteMessages(channelID string, msgs []Message) error {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+
+	name, ok := w.names[channelID]
+	if !ok {
+		name = channelID
+	}
+
+	days := map[string][]Message{}
+	for _, msg := range msgs {
+		day := exportDay(msg.Timestamp)
+		days[day] = append(days[day], msg)
+	}
+
+	for day, dayMsgs := range days {
+		file := filepath.Join(w.dir, name, day+".json")
+
+		existing, err := readExportMessages(file)
+		if err != nil {
+			return err
+		}
+
+		if err = w.writeJSON(file, mergeExportMessages(existing, dayMsgs)); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (w *ExportWriter) writeJSON(path string, v interface{}) error {
+	if err := os.MkdirAll(filepath.Dir(path), 0755); err != nil {
+		return err
+	}
+
+	b, err := json.MarshalIndent(v, "", "    ")
+	if err != nil {
+		return err
+	}
+
+	return ioutil.WriteFile(path, b, 0644)
+}
+
+func exportListFile(channel Channel) string {
+	switch {
+	case channel.IsIM:
+		return ExportDMsFile
+	case channel.IsMpIM:
+		return ExportMPIMsFile
+	case channel.IsPrivate || channel.IsGroup:
+		return ExportGroupsFile
+	default:
+		return ExportChannelsFile
+	}
+}
+
+// exportDay returns the UTC day of a message timestamp, formatted like the export file names.
+func exportDay(timestamp string) string {
+	seconds := timestamp
+	if i := strings.IndexByte(timestamp, '.'); i >= 0 {
+		seconds = timestamp[:i]
+	}
+
+	unix, _ := strconv.ParseInt(seconds, 10, 64)
+	return time.Unix(unix, 0).UTC().Format("2006-01-02")
+}
+
+func readExportMessages(path string) ([]Message, error) {
+	b, err := ioutil.ReadFile(path)
+	if os.IsNotExist(err) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	var msgs []Message
+	if err = json.Unmarshal(b, &msgs); err != nil {
+		return nil, err
+	}
+	return msgs, nil
+}
+
+// mergeExportMessages combines both lists, oldest first as in exports, the added messages replacing existing ones.
+func mergeExportMessages(existing, added []Message) []Message {
+	byTimestamp := make(map[string]Message, len(existing)+len(added))
+	for _, msg := range existing {
+		byTimestamp[msg.Timestamp] = msg
+	}
+	for _, msg := range added {
+		byTimestamp[msg.Timestamp] = msg
+	}
+
+	merged := make([]Message, 0, len(byTimestamp))
+	for _, msg := range byTimestamp {
+		merged = append(merged, msg)
+	}
+	sort.Slice(merged, func(i, j int) bool {
+		return merged[i].Timestamp < merged[j].Timestamp
+	})
+
+	return merged
+}
+
+// ExportReader reads the zip archives of the workspace exports made by slack, or written by ExportWriter once zipped.
+type ExportReader struct {
+	zr     *zip.Reader
+	closer io.Closer
+}
+
+// NewExportReader reads the export archive from r, of the given size.
+func NewExportReader(r io.ReaderAt, size int64) (*ExportReader, error) {
+	zr, err := zip.NewReader(r, size)
+	if err != nil {
+		return nil, err
+	}
+
+	return &ExportReader{zr: zr}, nil
+}
+
+// OpenExportReader opens the export archive at path, the reader must be closed once done.
+func OpenExportReader(path string) (*ExportReader, error) {
+	zr, err := zip.OpenReader(path)
+	if err != nil {
+		return nil, err
+	}
+
+	return &ExportReader{zr: &zr.Reader, closer: zr}, nil
+}
+
+// Close closes the archive opened by OpenExportReader.
+func (r *ExportReader) Close() error {
+	if r.closer == nil {
+		return nil
+	}
+	return r.closer.Close()
+}
+
+// Users returns the users listed in users.json.
+func (r *ExportReader) Users() ([]User, error) {
+	var users []User
+	if _, err := r.readJSON(ExportUsersFile, &users); err != nil {
+		return nil, err
+	}
+	return users, nil
+}
+
+// Channels returns the conversations listed in channels.json, groups.json, mpims.json and dms.json,
+// the files missing from the archive, e.g. because of the plan of the workspace, are skipped.
+// The type flags of the conversations are set according to the file listing them.
+func (r *ExportReader) Channels() ([]Channel, error) {
+	var all []Channel
+	for _, file := range []string{ExportChannelsFile, ExportGroupsFile, ExportMPIMsFile, ExportDMsFile} {
+		var channels []Channel
+		found, err := r.readJSON(file, &channels)
+		if err != nil {
+			return nil, err
+		}
+		if !found {
+			continue
+		}
+
+		for i := range channels {
+			switch file {
+			case ExportGroupsFile:
+				channels[i].IsPrivate = true
+			case ExportMPIMsFile:
+				channels[i].IsMpIM = true
+			case ExportDMsFile:
+				channels[i].IsIM = true
+			}
+		}
+		all = append(all, channels...)
+	}
+
+	return all, nil
+}
+
+// Messages returns the messages of the conversation, oldest first.
+func (r *ExportReader) Messages(channel Channel) ([]Message, error) {
+	dir := channel.Name
+	if channel.IsIM || dir == "" {
+		dir = channel.ID
+	}
+
+	var days []*zip.File
+	for _, f := range r.zr.File {
+		if path.Dir(f.Name) == dir && path.Ext(f.Name) == ".json" {
+			days = append(days, f)
+		}
+	}
+	sort.Slice(days, func(i, j int) bool {
+		return days[i].Name < days[j].Name
+	})
+
+	var msgs []Message
+	for _, f := range days {
+		var day []Message
+		if err := readZipJSON(f, &day); err != nil {
+			return nil, err
+		}
+		msgs = append(msgs, day...)
+	}
+
+	return msgs, nil
+}
+
+func (r *ExportReader) readJSON(name string, v interface{}) (bool, error) {
+	for _, f := range r.zr.File {
+		if f.Name == name {
+			return true, readZipJSON(f, v)
+		}
+	}
+	return false, nil
+}
+
+func readZipJSON(f *zip.File, v interface{}) error {
+	rc, err := f.Open()
+	if err != nil {
+		return err
+	}
+	defer rc.Close()
+
+	return json.NewDecoder(rc).Decode(v)
+}
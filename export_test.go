@@ -0,0 +1,152 @@
+package slack
+
+import (
+	"archive/zip"
+	"bytes"
+	"encoding/json"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"reflect"
+	"testing"
+)
+
+func TestExportWriter(t *testing.T) {
+	dir, err := ioutil.TempDir("", "slack-export")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+
+	w := NewExportWriter(dir)
+
+	general := Channel{}
+	general.ID, general.Name = "C1", "general"
+	secret := Channel{}
+	secret.ID, secret.Name, secret.IsPrivate = "G1", "secret", true
+	dm := Channel{}
+	dm.ID, dm.IsIM = "D1", true
+
+	if err = w.WriteUsers([]User{{ID: "U1", Name: "alice"}}); err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	if err = w.WriteChannels([]Channel{general, secret, dm}); err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	// 1591012800 is 2020-06-01T12:00:00Z.
+	pages := [][]Message{
+		{msgWithTs("1591012860.000200", "second"), msgWithTs("1591099200.000100", "next day")},
+		{msgWithTs("1591012800.000100", "first"), msgWithTs("1591012860.000200", "second, edited")},
+	}
+	for _, page := range pages {
+		if err = w.WriteMessages("C1", page); err != nil {
+			t.Fatalf("unexpected error: %s", err)
+		}
+	}
+	if err = w.WriteMessages("D1", []Message{msgWithTs("1591012800.000100", "hi")}); err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	var channels []Channel
+	readExportFile(t, filepath.Join(dir, "channels.json"), &channels)
+	if len(channels) != 1 || channels[0].ID != "C1" {
+		t.Errorf("unexpected channels.json: %+v", channels)
+	}
+	var groups []Channel
+	readExportFile(t, filepath.Join(dir, "groups.json"), &groups)
+	if len(groups) != 1 || groups[0].ID != "G1" {
+		t.Errorf("unexpected groups.json: %+v", groups)
+	}
+
+	var day []Message
+	readExportFile(t, filepath.Join(dir, "general", "2020-06-01.json"), &day)
+	if len(day) != 2 || day[0].Text != "first" || day[1].Text != "second, edited" {
+		t.Errorf("unexpected messages: %+v", day)
+	}
+	readExportFile(t, filepath.Join(dir, "general", "2020-06-02.json"), &day)
+	if len(day) != 1 || day[0].Text != "next day" {
+		t.Errorf("unexpected messages: %+v", day)
+	}
+	readExportFile(t, filepath.Join(dir, "D1", "2020-06-01.json"), &day)
+	if len(day) != 1 {
+		t.Errorf("unexpected direct messages: %+v", day)
+	}
+}
+
+func msgWithTs(ts, text string) Message {
+	msg := Message{}
+	msg.Timestamp, msg.Text = ts, text
+	return msg
+}
+
+func readExportFile(t *testing.T, path string, v interface{}) {
+	t.Helper()
+
+	b, err := ioutil.ReadFile(path)
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	if err = json.Unmarshal(b, v); err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+}
+
+func TestExportReader(t *testing.T) {
+	var buf bytes.Buffer
+	zw := zip.NewWriter(&buf)
+	for name, content := range map[string]string{
+		"users.json":                   `[{"id": "U1", "name": "alice"}]`,
+		"channels.json":                `[{"id": "C1", "name": "general"}]`,
+		"dms.json":                     `[{"id": "D1", "members": ["U1", "U2"]}]`,
+		"general/2020-06-02.json":      `[{"type": "message", "ts": "1591099200.000100", "text": "next day"}]`,
+		"general/2020-06-01.json":      `[{"type": "message", "ts": "1591012800.000100", "text": "first"}, {"type": "message", "ts": "1591012860.000200", "text": "second"}]`,
+		"D1/2020-06-01.json":           `[{"type": "message", "ts": "1591012800.000100", "text": "hi"}]`,
+		"integration_logs.json":        `[]`,
+		"general-archive/2019-01.json": `[{"ts": "1"}]`,
+	} {
+		f, err := zw.Create(name)
+		if err != nil {
+			t.Fatal(err)
+		}
+		f.Write([]byte(content))
+	}
+	if err := zw.Close(); err != nil {
+		t.Fatal(err)
+	}
+
+	r, err := NewExportReader(bytes.NewReader(buf.Bytes()), int64(buf.Len()))
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	users, err := r.Users()
+	if err != nil || len(users) != 1 || users[0].Name != "alice" {
+		t.Errorf("unexpected users: %+v %v", users, err)
+	}
+
+	channels, err := r.Channels()
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	if len(channels) != 2 || channels[0].ID != "C1" || !channels[1].IsIM {
+		t.Fatalf("unexpected channels: %+v", channels)
+	}
+
+	msgs, err := r.Messages(channels[0])
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	var texts []string
+	for _, msg := range msgs {
+		texts = append(texts, msg.Text)
+	}
+	if !reflect.DeepEqual(texts, []string{"first", "second", "next day"}) {
+		t.Errorf("unexpected messages: %v", texts)
+	}
+
+	msgs, err = r.Messages(channels[1])
+	if err != nil || len(msgs) != 1 || msgs[0].Text != "hi" {
+		t.Errorf("unexpected direct messages: %+v %v", msgs, err)
+	}
+}
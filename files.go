@@ -2,9 +2,11 @@ package slack
 
 import (
 	"context"
+	"encoding/json"
 	"fmt"
 	"io"
 	"net/url"
+	"path/filepath"
 	"strconv"
 	"strings"
 )
@@ -89,11 +91,75 @@ type File struct {
 	Shares          Share    `json:"shares"`
 }
 
+// File modes, see File.Mode.
+const (
+	FileModeHosted        = "hosted"
+	FileModeExternal      = "external"
+	FileModeSnippet       = "snippet"
+	FileModePost          = "post"
+	FileModeTombstone     = "tombstone"
+	FileModeHiddenByLimit = "hidden_by_limit"
+)
+
+// IsTombstoned reports whether the file has been deleted, slack keeps a placeholder
+// with most of the fields removed.
+func (t File) IsTombstoned() bool {
+	return t.Mode == FileModeTombstone
+}
+
+// IsHiddenByLimit reports whether the file is hidden because the workspace exceeded its storage limit.
+func (t File) IsHiddenByLimit() bool {
+	return t.Mode == FileModeHiddenByLimit
+}
+
+// PublicURL returns a direct link to the file contents that can be accessed
+// without authentication, suitable for use in image blocks. The file must
+// have been shared publicly, see ShareFilePublicURL.
+func (t File) PublicURL() (string, error) {
+	if !t.PublicURLShared || t.PermalinkPublic == "" {
+		return "", ErrFileNotPublic
+	}
+
+	// permalink_public has the form https://slack-files.com/TEAMID-FILEID-SECRET
+	permalink, err := url.Parse(t.PermalinkPublic)
+	if err != nil {
+		return "", err
+	}
+
+	parts := strings.Split(strings.Trim(permalink.Path, "/"), "-")
+	if len(parts) != 3 || parts[2] == "" {
+		return "", fmt.Errorf("unexpected public permalink format: %s", t.PermalinkPublic)
+	}
+
+	u, err := url.Parse(t.URLPrivate)
+	if err != nil {
+		return "", err
+	}
+
+	query := u.Query()
+	query.Set("pub_secret", parts[2])
+	u.RawQuery = query.Encode()
+
+	return u.String(), nil
+}
+
 type Share struct {
 	Public  map[string][]ShareFileInfo `json:"public"`
 	Private map[string][]ShareFileInfo `json:"private"`
 }
 
+// UnmarshalJSON is the implementation of the json.Unmarshaler interface.
+// slack sends an empty array instead of an object for files that aren't shared,
+// tombstoned files in particular.
+func (t *Share) UnmarshalJSON(b []byte) error {
+	if string(b) == "[]" {
+		return nil
+	}
+
+	type alias Share
+	return json.Unmarshal(b, (*alias)(t))
+}
+
 type ShareFileInfo struct {
 	ReplyUsers      []string `json:"reply_users"`
 	ReplyUsersCount int      `json:"reply_users_count"`
@@ -111,6 +177,8 @@ type ShareFileInfo struct {
 // or provide a local file path in File to upload it from your filesystem.
 //
 // Note that when using the Reader option, you *must* specify the Filename, otherwise the Slack API isn't happy.
+//
+// When Filetype is empty it is detected from the Filename (or File), see DetectFiletype.
 type FileUploadParameters struct {
 	File            string
 	Content         string
@@ -121,6 +189,8 @@ type FileUploadParameters struct {
 	InitialComment  string
 	Channels        []string
 	ThreadTimestamp string
+	// Multipart controls the layout of the multipart body when uploading File or Reader.
+	Multipart MultipartOptions
 }
 
 // GetFilesParameters contains all the parameters necessary (including the optional ones) for a GetFiles() request
@@ -176,7 +246,8 @@ func (api *Client) fileRequest(ctx context.Context, path string, values url.Valu
 	return response, response.Err()
 }
 
-// GetFileInfo retrieves a file and related comments
+// GetFileInfo retrieves a file and related comments.
+// Files that have been deleted or hidden are returned along with ErrFileTombstoned or ErrFileHiddenByLimit.
 func (api *Client) GetFileInfo(fileID string, count, page int) (*File, []Comment, *Paging, error) {
 	return api.GetFileInfoContext(context.Background(), fileID, count, page)
 }
@@ -194,12 +265,57 @@ func (api *Client) GetFileInfoContext(ctx context.Context, fileID string, count,
 	if err != nil {
 		return nil, nil, nil, err
 	}
-	return &response.File, response.Comments, &response.Paging, nil
+	return &response.File, response.Comments, &response.Paging, fileModeErr(&response.File)
+}
+
+// GetFileComments retrieves a page of comments for a file. Uses cursor based pagination,
+// the returned cursor is empty once all comments have been retrieved.
+func (api *Client) GetFileComments(fileID, cursor string, limit int) ([]Comment, string, error) {
+	return api.GetFileCommentsContext(context.Background(), fileID, cursor, limit)
+}
+
+// GetFileCommentsContext retrieves a page of comments for a file with a custom context. Uses cursor based pagination.
+func (api *Client) GetFileCommentsContext(ctx context.Context, fileID, cursor string, limit int) ([]Comment, string, error) {
+	values := url.Values{
+		"token": {api.token},
+		"file":  {fileID},
+	}
+	if cursor != "" {
+		values.Add("cursor", cursor)
+	}
+	if limit != 0 {
+		values.Add("limit", strconv.Itoa(limit))
+	}
+
+	response, err := api.fileRequest(ctx, "files.info", values)
+	if err != nil {
+		return nil, "", err
+	}
+	if err = fileModeErr(&response.File); err != nil {
+		return nil, "", err
+	}
+	return response.Comments, response.Metadata.Cursor, nil
+}
+
+// fileModeErr converts the modes of files that are no longer available into errors.
+func fileModeErr(f *File) error {
+	switch f.Mode {
+	case FileModeTombstone:
+		return ErrFileTombstoned
+	case FileModeHiddenByLimit:
+		return ErrFileHiddenByLimit
+	default:
+		return nil
+	}
 }
 
 // GetFile retreives a given file from its private download URL
 func (api *Client) GetFile(downloadURL string, writer io.Writer) error {
-	return downloadFile(api.httpclient, api.token, downloadURL, writer, api)
+	token, err := api.currentToken(context.Background())
+	if err != nil {
+		return err
+	}
+	return downloadFile(api.httpclient, token, downloadURL, writer, api)
 }
 
 // GetFiles retrieves all files according to the parameters given
@@ -292,6 +408,13 @@ func (api *Client) UploadFileContext(ctx context.Context, params FileUploadParam
 	values := url.Values{
 		"token": {api.token},
 	}
+	if params.Filetype == "" {
+		filename := params.Filename
+		if filename == "" && params.File != "" {
+			filename = filepath.Base(params.File)
+		}
+		params.Filetype = DetectFiletype(filename, "")
+	}
 	if params.Filetype != "" {
 		values.Add("filetype", params.Filetype)
 	}
@@ -310,16 +433,19 @@ func (api *Client) UploadFileContext(ctx context.Context, params FileUploadParam
 	if len(params.Channels) != 0 {
 		values.Add("channels", strings.Join(params.Channels, ","))
 	}
+	if err = api.authenticate(ctx, values); err != nil {
+		return nil, err
+	}
 	if params.Content != "" {
 		values.Add("content", params.Content)
 		err = api.postMethod(ctx, "files.upload", values, response)
 	} else if params.File != "" {
-		err = postLocalWithMultipartResponse(ctx, api.httpclient, api.endpoint+"files.upload", params.File, "file", values, response, api)
+		err = postLocalWithMultipartResponse(ctx, api.httpclient, api.endpoint+"files.upload", params.File, "file", values, response, api, params.Multipart)
 	} else if params.Reader != nil {
 		if params.Filename == "" {
 			return nil, fmt.Errorf("files.upload: FileUploadParameters.Filename is mandatory when using FileUploadParameters.Reader")
 		}
-		err = postWithMultipartResponse(ctx, api.httpclient, api.endpoint+"files.upload", params.Filename, "file", values, params.Reader, response, api)
+		err = postWithMultipartResponse(ctx, api.httpclient, api.endpoint+"files.upload", params.Filename, "file", values, params.Reader, response, api, params.Multipart)
 	}
 
 	if err != nil {
@@ -211,3 +211,83 @@ func TestUploadFileWithoutFilename(t *testing.T) {
 		t.Errorf("Error message should mention empty FileUploadParameters.Filename")
 	}
 }
+
+func getFileInfoHandler(rw http.ResponseWriter, r *http.Request) {
+	rw.Header().Set("Content-Type", "application/json")
+	switch r.FormValue("file") {
+	case "FTOMBSTONE":
+		rw.Write([]byte(`{"ok": true, "file": {"id": "FTOMBSTONE", "mode": "tombstone", "shares": []}, "comments": []}`))
+	default:
+		if r.FormValue("cursor") == "" {
+			rw.Write([]byte(`{"ok": true, "file": {"id": "F1", "mode": "hosted"}, "comments": [{"id": "Fc1"}], "response_metadata": {"next_cursor": "page2"}}`))
+			return
+		}
+		rw.Write([]byte(`{"ok": true, "file": {"id": "F1", "mode": "hosted"}, "comments": [{"id": "Fc2"}], "response_metadata": {"next_cursor": ""}}`))
+	}
+}
+
+func TestGetFileComments(t *testing.T) {
+	http.HandleFunc("/files.info", getFileInfoHandler)
+	once.Do(startServer)
+	api := New("testing-token", OptionAPIURL("http://"+serverAddr+"/"))
+
+	var (
+		ids    []string
+		cursor string
+	)
+	for {
+		comments, next, err := api.GetFileComments("F1", cursor, 1)
+		if err != nil {
+			t.Fatalf("Unexpected error: %s", err)
+		}
+		for _, c := range comments {
+			ids = append(ids, c.ID)
+		}
+		if next == "" {
+			break
+		}
+		cursor = next
+	}
+
+	if !reflect.DeepEqual([]string{"Fc1", "Fc2"}, ids) {
+		t.Errorf("unexpected comments: %v", ids)
+	}
+
+	file, _, _, err := api.GetFileInfo("FTOMBSTONE", 100, 1)
+	if err != ErrFileTombstoned {
+		t.Fatalf("expected ErrFileTombstoned, got: %v", err)
+	}
+	if !file.IsTombstoned() {
+		t.Errorf("expected file to be tombstoned")
+	}
+
+	if _, _, err = api.GetFileComments("FTOMBSTONE", "", 0); err != ErrFileTombstoned {
+		t.Errorf("expected ErrFileTombstoned, got: %v", err)
+	}
+}
+
+func TestFilePublicURL(t *testing.T) {
+	file := File{
+		PublicURLShared: true,
+		URLPrivate:      "https://files.slack.com/files-pri/T1234-F5678/image.png",
+		PermalinkPublic: "https://slack-files.com/T1234-F5678-0123456789",
+	}
+
+	u, err := file.PublicURL()
+	if err != nil {
+		t.Fatalf("Unexpected error: %s", err)
+	}
+	if expected := "https://files.slack.com/files-pri/T1234-F5678/image.png?pub_secret=0123456789"; u != expected {
+		t.Errorf("expected %s, got %s", expected, u)
+	}
+
+	file.PermalinkPublic = "https://slack-files.com/invalid"
+	if _, err = file.PublicURL(); err == nil {
+		t.Errorf("expected error for malformed permalink")
+	}
+
+	file.PublicURLShared = false
+	if _, err = file.PublicURL(); err != ErrFileNotPublic {
+		t.Errorf("expected ErrFileNotPublic, got: %v", err)
+	}
+}
@@ -0,0 +1,340 @@
+package slack
+
+import (
+	"mime"
+	"path/filepath"
+	"strings"
+)
+
+// Filetypes understood by slack, for use with FileUploadParameters.Filetype.
+//
+// More Information: https://api.slack.com/types/file#file_types
+const (
+	FiletypeAuto         = "auto"
+	FiletypeText         = "text"
+	FiletypeAI           = "ai"
+	FiletypeAPK          = "apk"
+	FiletypeAppleScript  = "applescript"
+	FiletypeBinary       = "binary"
+	FiletypeBMP          = "bmp"
+	FiletypeBoxNote      = "boxnote"
+	FiletypeC            = "c"
+	FiletypeCSharp       = "csharp"
+	FiletypeCPP          = "cpp"
+	FiletypeCSS          = "css"
+	FiletypeCSV          = "csv"
+	FiletypeClojure      = "clojure"
+	FiletypeCoffeeScript = "coffeescript"
+	FiletypeCFM          = "cfm"
+	FiletypeD            = "d"
+	FiletypeDart         = "dart"
+	FiletypeDiff         = "diff"
+	FiletypeDoc          = "doc"
+	FiletypeDocx         = "docx"
+	FiletypeDockerfile   = "dockerfile"
+	FiletypeDotx         = "dotx"
+	FiletypeEmail        = "email"
+	FiletypeEPS          = "eps"
+	FiletypeEPUB         = "epub"
+	FiletypeErlang       = "erlang"
+	FiletypeFLA          = "fla"
+	FiletypeFLV          = "flv"
+	FiletypeFSharp       = "fsharp"
+	FiletypeFortran      = "fortran"
+	FiletypeGo           = "go"
+	FiletypeGroovy       = "groovy"
+	FiletypeGDoc         = "gdoc"
+	FiletypeGDraw        = "gdraw"
+	FiletypeGPres        = "gpres"
+	FiletypeGSheet       = "gsheet"
+	FiletypeGIF          = "gif"
+	FiletypeGzip         = "gzip"
+	FiletypeHTML         = "html"
+	FiletypeHandlebars   = "handlebars"
+	FiletypeHaskell      = "haskell"
+	FiletypeHaxe         = "haxe"
+	FiletypeINDD         = "indd"
+	FiletypeJava         = "java"
+	FiletypeJavascript   = "javascript"
+	FiletypeJPG          = "jpg"
+	FiletypeJSON         = "json"
+	FiletypeJSP          = "jsp"
+	FiletypeKotlin       = "kotlin"
+	FiletypeLatex        = "latex"
+	FiletypeLisp         = "lisp"
+	FiletypeLua          = "lua"
+	FiletypeM4A          = "m4a"
+	FiletypeMarkdown     = "markdown"
+	FiletypeMatlab       = "matlab"
+	FiletypeMHTML        = "mhtml"
+	FiletypeMKV          = "mkv"
+	FiletypeMOV          = "mov"
+	FiletypeMP3          = "mp3"
+	FiletypeMP4          = "mp4"
+	FiletypeMPG          = "mpg"
+	FiletypeMumps        = "mumps"
+	FiletypeNZB          = "nzb"
+	FiletypeObjC         = "objc"
+	FiletypeOCaml        = "ocaml"
+	FiletypeODG          = "odg"
+	FiletypeODI          = "odi"
+	FiletypeODP          = "odp"
+	FiletypeODS          = "ods"
+	FiletypeODT          = "odt"
+	FiletypeOGG          = "ogg"
+	FiletypeOGV          = "ogv"
+	FiletypePascal       = "pascal"
+	FiletypePDF          = "pdf"
+	FiletypePerl         = "perl"
+	FiletypePHP          = "php"
+	FiletypePig          = "pig"
+	FiletypePNG          = "png"
+	FiletypePost         = "post"
+	FiletypePowershell   = "powershell"
+	FiletypePPT          = "ppt"
+	FiletypePPTX         = "pptx"
+	FiletypePSD          = "psd"
+	FiletypePuppet       = "puppet"
+	FiletypePython       = "python"
+	FiletypeQTZ          = "qtz"
+	FiletypeR            = "r"
+	FiletypeRTF          = "rtf"
+	FiletypeRuby         = "ruby"
+	FiletypeRust         = "rust"
+	FiletypeSQL          = "sql"
+	FiletypeSass         = "sass"
+	FiletypeScala        = "scala"
+	FiletypeScheme       = "scheme"
+	FiletypeShell        = "shell"
+	FiletypeSketch       = "sketch"
+	FiletypeSmalltalk    = "smalltalk"
+	FiletypeSVG          = "svg"
+	FiletypeSWF          = "swf"
+	FiletypeSwift        = "swift"
+	FiletypeTar          = "tar"
+	FiletypeTIFF         = "tiff"
+	FiletypeTSV          = "tsv"
+	FiletypeVB           = "vb"
+	FiletypeVBScript     = "vbscript"
+	FiletypeVCard        = "vcard"
+	FiletypeVelocity     = "velocity"
+	FiletypeVerilog      = "verilog"
+	FiletypeWAV          = "wav"
+	FiletypeWebM         = "webm"
+	FiletypeWMV          = "wmv"
+	FiletypeXLS          = "xls"
+	FiletypeXLSX         = "xlsx"
+	FiletypeXLSB         = "xlsb"
+	FiletypeXLSM         = "xlsm"
+	FiletypeXLTX         = "xltx"
+	FiletypeXML          = "xml"
+	FiletypeYAML         = "yaml"
+	FiletypeZip          = "zip"
+)
+
+var filetypesByExtension = map[string]string{
+	".ai":          FiletypeAI,
+	".apk":         FiletypeAPK,
+	".applescript": FiletypeAppleScript,
+	".bin":         FiletypeBinary,
+	".bmp":         FiletypeBMP,
+	".boxnote":     FiletypeBoxNote,
+	".c":           FiletypeC,
+	".h":           FiletypeC,
+	".cs":          FiletypeCSharp,
+	".cpp":         FiletypeCPP,
+	".cc":          FiletypeCPP,
+	".cxx":         FiletypeCPP,
+	".hpp":         FiletypeCPP,
+	".css":         FiletypeCSS,
+	".csv":         FiletypeCSV,
+	".clj":         FiletypeClojure,
+	".cljs":        FiletypeClojure,
+	".coffee":      FiletypeCoffeeScript,
+	".cfm":         FiletypeCFM,
+	".d":           FiletypeD,
+	".dart":        FiletypeDart,
+	".diff":        FiletypeDiff,
+	".patch":       FiletypeDiff,
+	".doc":         FiletypeDoc,
+	".docx":        FiletypeDocx,
+	".dotx":        FiletypeDotx,
+	".eml":         FiletypeEmail,
+	".eps":         FiletypeEPS,
+	".epub":        FiletypeEPUB,
+	".erl":         FiletypeErlang,
+	".fla":         FiletypeFLA,
+	".flv":         FiletypeFLV,
+	".fs":          FiletypeFSharp,
+	".f":           FiletypeFortran,
+	".f90":         FiletypeFortran,
+	".go":          FiletypeGo,
+	".groovy":      FiletypeGroovy,
+	".gif":         FiletypeGIF,
+	".gz":          FiletypeGzip,
+	".html":        FiletypeHTML,
+	".htm":         FiletypeHTML,
+	".hbs":         FiletypeHandlebars,
+	".handlebars":  FiletypeHandlebars,
+	".hs":          FiletypeHaskell,
+	".hx":          FiletypeHaxe,
+	".indd":        FiletypeINDD,
+	".java":        FiletypeJava,
+	".js":          FiletypeJavascript,
+	".jsx":         FiletypeJavascript,
+	".mjs":         FiletypeJavascript,
+	".jpg":         FiletypeJPG,
+	".jpeg":        FiletypeJPG,
+	".json":        FiletypeJSON,
+	".jsp":         FiletypeJSP,
+	".kt":          FiletypeKotlin,
+	".kts":         FiletypeKotlin,
+	".tex":         FiletypeLatex,
+	".lisp":        FiletypeLisp,
+	".lua":         FiletypeLua,
+	".m4a":         FiletypeM4A,
+	".md":          FiletypeMarkdown,
+	".markdown":    FiletypeMarkdown,
+	".m":           FiletypeMatlab,
+	".mhtml":       FiletypeMHTML,
+	".mkv":         FiletypeMKV,
+	".mov":         FiletypeMOV,
+	".mp3":         FiletypeMP3,
+	".mp4":         FiletypeMP4,
+	".mpg":         FiletypeMPG,
+	".mpeg":        FiletypeMPG,
+	".mumps":       FiletypeMumps,
+	".nzb":         FiletypeNZB,
+	".mm":          FiletypeObjC,
+	".ml":          FiletypeOCaml,
+	".odg":         FiletypeODG,
+	".odi":         FiletypeODI,
+	".odp":         FiletypeODP,
+	".ods":         FiletypeODS,
+	".odt":         FiletypeODT,
+	".ogg":         FiletypeOGG,
+	".ogv":         FiletypeOGV,
+	".pas":         FiletypePascal,
+	".pdf":         FiletypePDF,
+	".pl":          FiletypePerl,
+	".pm":          FiletypePerl,
+	".php":         FiletypePHP,
+	".pig":         FiletypePig,
+	".png":         FiletypePNG,
+	".ps1":         FiletypePowershell,
+	".ppt":         FiletypePPT,
+	".pptx":        FiletypePPTX,
+	".psd":         FiletypePSD,
+	".pp":          FiletypePuppet,
+	".py":          FiletypePython,
+	".qtz":         FiletypeQTZ,
+	".r":           FiletypeR,
+	".rtf":         FiletypeRTF,
+	".rb":          FiletypeRuby,
+	".rs":          FiletypeRust,
+	".sql":         FiletypeSQL,
+	".sass":        FiletypeSass,
+	".scss":        FiletypeSass,
+	".scala":       FiletypeScala,
+	".scm":         FiletypeScheme,
+	".sh":          FiletypeShell,
+	".bash":        FiletypeShell,
+	".zsh":         FiletypeShell,
+	".sketch":      FiletypeSketch,
+	".st":          FiletypeSmalltalk,
+	".svg":         FiletypeSVG,
+	".swf":         FiletypeSWF,
+	".swift":       FiletypeSwift,
+	".tar":         FiletypeTar,
+	".tif":         FiletypeTIFF,
+	".tiff":        FiletypeTIFF,
+	".tsv":         FiletypeTSV,
+	".txt":         FiletypeText,
+	".log":         FiletypeText,
+	".vb":          FiletypeVB,
+	".vbs":         FiletypeVBScript,
+	".vcf":         FiletypeVCard,
+	".vm":          FiletypeVelocity,
+	".v":           FiletypeVerilog,
+	".wav":         FiletypeWAV,
+	".webm":        FiletypeWebM,
+	".wmv":         FiletypeWMV,
+	".xls":         FiletypeXLS,
+	".xlsx":        FiletypeXLSX,
+	".xlsb":        FiletypeXLSB,
+	".xlsm":        FiletypeXLSM,
+	".xltx":        FiletypeXLTX,
+	".xml":         FiletypeXML,
+	".yaml":        FiletypeYAML,
+	".yml":         FiletypeYAML,
+	".zip":         FiletypeZip,
+}
+
+var filetypesByName = map[string]string{
+	"dockerfile": FiletypeDockerfile,
+	"makefile":   FiletypeText,
+}
+
+var filetypesByMimetype = map[string]string{
+	"application/json":              FiletypeJSON,
+	"application/pdf":               FiletypePDF,
+	"application/xml":               FiletypeXML,
+	"application/zip":               FiletypeZip,
+	"application/gzip":              FiletypeGzip,
+	"application/x-gzip":            FiletypeGzip,
+	"application/x-tar":             FiletypeTar,
+	"application/javascript":        FiletypeJavascript,
+	"application/x-yaml":            FiletypeYAML,
+	"application/rtf":               FiletypeRTF,
+	"application/msword":            FiletypeDoc,
+	"application/vnd.ms-excel":      FiletypeXLS,
+	"application/vnd.ms-powerpoint": FiletypePPT,
+	"application/epub+zip":          FiletypeEPUB,
+	"application/octet-stream":      FiletypeBinary,
+	"image/bmp":                     FiletypeBMP,
+	"image/gif":                     FiletypeGIF,
+	"image/jpeg":                    FiletypeJPG,
+	"image/png":                     FiletypePNG,
+	"image/svg+xml":                 FiletypeSVG,
+	"image/tiff":                    FiletypeTIFF,
+	"audio/mpeg":                    FiletypeMP3,
+	"audio/wav":                     FiletypeWAV,
+	"audio/ogg":                     FiletypeOGG,
+	"video/mp4":                     FiletypeMP4,
+	"video/mpeg":                    FiletypeMPG,
+	"video/quicktime":               FiletypeMOV,
+	"video/webm":                    FiletypeWebM,
+	"video/ogg":                     FiletypeOGV,
+	"text/css":                      FiletypeCSS,
+	"text/csv":                      FiletypeCSV,
+	"text/html":                     FiletypeHTML,
+	"text/markdown":                 FiletypeMarkdown,
+	"text/plain":                    FiletypeText,
+	"text/xml":                      FiletypeXML,
+	"text/tab-separated-values":     FiletypeTSV,
+	"text/calendar":                 FiletypeText,
+	"text/vcard":                    FiletypeVCard,
+	"message/rfc822":                FiletypeEmail,
+}
+
+// DetectFiletype returns the slack filetype for a file, based on its name and
+// optionally its mimetype. The name takes precedence, an empty string is returned
+// when the filetype can't be determined, which lets slack detect it instead.
+func DetectFiletype(filename, mimetype string) string {
+	base := strings.ToLower(filepath.Base(filename))
+	if ft, ok := filetypesByName[base]; ok {
+		return ft
+	}
+
+	if ft, ok := filetypesByExtension[strings.ToLower(filepath.Ext(base))]; ok {
+		return ft
+	}
+
+	if mimetype != "" {
+		if mt, _, err := mime.ParseMediaType(mimetype); err == nil {
+			return filetypesByMimetype[mt]
+		}
+	}
+
+	return ""
+}
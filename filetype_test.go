@@ -0,0 +1,24 @@
+package slack
+
+import (
+	"testing"
+)
+
+func TestDetectFiletype(t *testing.T) {
+	test := func(filename, mimetype, expected string) {
+		if computed := DetectFiletype(filename, mimetype); computed != expected {
+			t.Errorf("expected (%q, %q) to have filetype %q, got: %q", filename, mimetype, expected, computed)
+		}
+	}
+
+	test("main.go", "", FiletypeGo)
+	test("/tmp/Script.PY", "", FiletypePython)
+	test("Dockerfile", "", FiletypeDockerfile)
+	test("config.yml", "", FiletypeYAML)
+	test("notes.txt", "application/json", FiletypeText)
+	test("upload", "application/json; charset=utf-8", FiletypeJSON)
+	test("upload", "image/png", FiletypePNG)
+	test("upload", "", "")
+	test("", "", "")
+	test("archive.unknown", "application/x-unknown", "")
+}
@@ -0,0 +1,44 @@
+package slack
+
+import (
+	"net/http"
+)
+
+// OptionHeader adds a header to all the requests of the client, and to the handshake of its
+// RTM connections, e.g. to opt into a beta distributed by slack through a feature flag header.
+// It can be provided multiple times, including for the same key to send multiple values.
+// Headers set by the library itself, such as Authorization and Content-Type, take precedence.
+func OptionHeader(key, value string) func(*Client) {
+	return func(c *Client) {
+		if c.headers == nil {
+			c.headers = http.Header{}
+		}
+		c.headers.Add(key, value)
+	}
+}
+
+// headerClient adds headers to the requests sent by an http client.
+type headerClient struct {
+	client httpClient
+	header http.Header
+}
+
+func (c headerClient) Do(req *http.Request) (*http.Response, error) {
+	for key, values := range c.header {
+		if _, ok := req.Header[key]; ok {
+			continue
+		}
+		req.Header[key] = append([]string(nil), values...)
+	}
+	return c.client.Do(req)
+}
+
+// configureHeaders wraps the http client to add the headers of the client to its requests,
+// it must be called after configureTransport which requires an *http.Client.
+func (api *Client) configureHeaders() {
+	if len(api.headers) == 0 {
+		return
+	}
+
+	api.httpclient = headerClient{client: api.httpclient, header: api.headers}
+}
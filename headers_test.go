@@ -0,0 +1,41 @@
+package slack
+
+import (
+	"net/http"
+	"reflect"
+	"testing"
+)
+
+func TestOptionHeader(t *testing.T) {
+	http.DefaultServeMux = new(http.ServeMux)
+	defer func() { http.DefaultServeMux = new(http.ServeMux) }()
+
+	var received http.Header
+	http.HandleFunc("/auth.test", func(rw http.ResponseWriter, r *http.Request) {
+		received = r.Header
+		rw.Header().Set("Content-Type", "application/json")
+		rw.Write([]byte(`{"ok": true}`))
+	})
+	once.Do(startServer)
+	api := New(validToken,
+		OptionAPIURL("http://"+serverAddr+"/"),
+		OptionHeader("X-Slack-Beta", "new-feature"),
+		OptionHeader("X-Slack-Beta", "other-feature"),
+		OptionHeader("Content-Type", "text/plain"),
+	)
+
+	if _, err := api.AuthTest(); err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	if got := received["X-Slack-Beta"]; !reflect.DeepEqual(got, []string{"new-feature", "other-feature"}) {
+		t.Errorf("unexpected beta headers: %v", got)
+	}
+	if got := received.Get("Content-Type"); got != "application/x-www-form-urlencoded" {
+		t.Errorf("expected the content type of the library to take precedence, got %q", got)
+	}
+
+	rtm := api.NewRTM(RTMOptionDialHeader(http.Header{"X-Slack-Beta": {"rtm-feature"}}))
+	if got := rtm.upgradeHeader()["X-Slack-Beta"]; !reflect.DeepEqual(got, []string{"new-feature", "other-feature", "rtm-feature"}) {
+		t.Errorf("unexpected handshake headers: %v", got)
+	}
+}
@@ -0,0 +1,158 @@
+package slack
+
+import (
+	"context"
+	"regexp"
+	"strings"
+)
+
+// RouteMatch describes how an interaction matched a route.
+type RouteMatch struct {
+	// ID is the action_id or callback_id that matched.
+	ID string
+	// Action is the block action being handled, it is nil for callback_id routes.
+	Action *BlockAction
+	// Args are the submatches of a regexp route, or the remainder of the ID for a prefix route.
+	Args []string
+	// Params are the named submatches of a regexp route.
+	Params map[string]string
+}
+
+// InteractionHandler handles an interaction matched by an InteractionRouter.
+type InteractionHandler func(ctx context.Context, callback *InteractionCallback, match RouteMatch) error
+
+type interactionRoute struct {
+	match   func(id string) (RouteMatch, bool)
+	handler InteractionHandler
+}
+
+// InteractionRouter dispatches interactions to handlers. Block actions are routed on
+// their action_id, every other interaction is routed on its callback_id.
+// Routes are tried in the order they were registered, the first match wins.
+type InteractionRouter struct {
+	actions   []interactionRoute
+	callbacks []interactionRoute
+}
+
+// NewInteractionRouter creates an empty router.
+func NewInteractionRouter() *InteractionRouter {
+	return &InteractionRouter{}
+}
+
+// Action routes block actions with the given action_id to h.
+func (r *InteractionRouter) Action(actionID string, h InteractionHandler) {
+	r.actions = append(r.actions, interactionRoute{match: matchExact(actionID), handler: h})
+}
+
+// ActionPrefix routes block actions whose action_id starts with prefix to h.
+func (r *InteractionRouter) ActionPrefix(prefix string, h InteractionHandler) {
+	r.actions = append(r.actions, interactionRoute{match: matchPrefix(prefix), handler: h})
+}
+
+// ActionRegexp routes block actions whose action_id entirely matches re to h.
+func (r *InteractionRouter) ActionRegexp(re *regexp.Regexp, h InteractionHandler) {
+	r.actions = append(r.actions, interactionRoute{match: matchRegexp(re), handler: h})
+}
+
+// Callback routes interactions with the given callback_id to h.
+func (r *InteractionRouter) Callback(callbackID string, h InteractionHandler) {
+	r.callbacks = append(r.callbacks, interactionRoute{match: matchExact(callbackID), handler: h})
+}
+
+// CallbackPrefix routes interactions whose callback_id starts with prefix to h.
+func (r *InteractionRouter) CallbackPrefix(prefix string, h InteractionHandler) {
+	r.callbacks = append(r.callbacks, interactionRoute{match: matchPrefix(prefix), handler: h})
+}
+
+// CallbackRegexp routes interactions whose callback_id entirely matches re to h.
+func (r *InteractionRouter) CallbackRegexp(re *regexp.Regexp, h InteractionHandler) {
+	r.callbacks = append(r.callbacks, interactionRoute{match: matchRegexp(re), handler: h})
+}
+
+// Handle dispatches the callback, each block action is dispatched separately.
+// It reports whether any route matched and returns the first error from a handler.
+func (r *InteractionRouter) Handle(ctx context.Context, callback *InteractionCallback) (bool, error) {
+	if callback.Type == InteractionTypeBlockActions {
+		handled := false
+		for _, action := range callback.ActionCallback.BlockActions {
+			route, match, ok := findRoute(r.actions, action.ActionID)
+			if !ok {
+				continue
+			}
+
+			handled = true
+			match.Action = action
+			if err := route.handler(ctx, callback, match); err != nil {
+				return true, err
+			}
+		}
+		return handled, nil
+	}
+
+	id := callback.CallbackID
+	if id == "" {
+		id = callback.View.CallbackID
+	}
+
+	route, match, ok := findRoute(r.callbacks, id)
+	if !ok {
+		return false, nil
+	}
+
+	return true, route.handler(ctx, callback, match)
+}
+
+func findRoute(routes []interactionRoute, id string) (interactionRoute, RouteMatch, bool) {
+	if id == "" {
+		return interactionRoute{}, RouteMatch{}, false
+	}
+
+	for _, route := range routes {
+		if match, ok := route.match(id); ok {
+			return route, match, true
+		}
+	}
+
+	return interactionRoute{}, RouteMatch{}, false
+}
+
+func matchExact(expected string) func(string) (RouteMatch, bool) {
+	return func(id string) (RouteMatch, bool) {
+		return RouteMatch{ID: id}, id == expected
+	}
+}
+
+func matchPrefix(prefix string) func(string) (RouteMatch, bool) {
+	return func(id string) (RouteMatch, bool) {
+		if !strings.HasPrefix(id, prefix) {
+			return RouteMatch{}, false
+		}
+		return RouteMatch{ID: id, Args: []string{strings.TrimPrefix(id, prefix)}}, true
+	}
+}
+
+func matchRegexp(re *regexp.Regexp) func(string) (RouteMatch, bool) {
+	// anchor the whole expression so alternations like a|ab must match the entire ID,
+	// the non-capturing group keeps the submatch indexes and names of re.
+	anchored := regexp.MustCompile("^(?:" + re.String() + ")$")
+	names := anchored.SubexpNames()
+	return func(id string) (RouteMatch, bool) {
+		loc := anchored.FindStringSubmatchIndex(id)
+		if loc == nil {
+			return RouteMatch{}, false
+		}
+
+		match := RouteMatch{ID: id, Params: map[string]string{}}
+		for i := 1; i < len(loc)/2; i++ {
+			var arg string
+			if loc[2*i] >= 0 {
+				arg = id[loc[2*i]:loc[2*i+1]]
+			}
+			match.Args = append(match.Args, arg)
+			if names[i] != "" {
+				match.Params[names[i]] = arg
+			}
+		}
+		return match, true
+	}
+}
@@ -0,0 +1,98 @@
+package slack
+
+import (
+	"context"
+	"errors"
+	"reflect"
+	"regexp"
+	"testing"
+)
+
+func TestInteractionRouterActions(t *testing.T) {
+	var got []RouteMatch
+	record := func(ctx context.Context, callback *InteractionCallback, match RouteMatch) error {
+		match.Action = nil
+		got = append(got, match)
+		return nil
+	}
+
+	router := NewInteractionRouter()
+	router.Action("refresh", record)
+	router.ActionRegexp(regexp.MustCompile(`approve_(?P<request>\d+)`), record)
+	router.ActionPrefix("page_", record)
+
+	callback := &InteractionCallback{
+		Type: InteractionTypeBlockActions,
+		ActionCallback: ActionCallbacks{BlockActions: []*BlockAction{
+			{ActionID: "approve_42"},
+			{ActionID: "approve_42x"},
+			{ActionID: "page_3"},
+			{ActionID: "refresh"},
+		}},
+	}
+
+	handled, err := router.Handle(context.Background(), callback)
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	if !handled {
+		t.Fatal("expected the callback to be handled")
+	}
+
+	want := []RouteMatch{
+		{ID: "approve_42", Args: []string{"42"}, Params: map[string]string{"request": "42"}},
+		{ID: "page_3", Args: []string{"3"}},
+		{ID: "refresh"},
+	}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("expected %+v, got %+v", want, got)
+	}
+}
+
+func TestInteractionRouterCallbacks(t *testing.T) {
+	errHandler := errors.New("handler failed")
+
+	router := NewInteractionRouter()
+	router.CallbackPrefix("survey:", func(ctx context.Context, callback *InteractionCallback, match RouteMatch) error {
+		if match.Args[0] != "onboarding" {
+			t.Errorf("unexpected args: %v", match.Args)
+		}
+		return errHandler
+	})
+
+	callback := &InteractionCallback{Type: InteractionTypeViewSubmission, View: View{CallbackID: "survey:onboarding"}}
+	if handled, err := router.Handle(context.Background(), callback); !handled || err != errHandler {
+		t.Errorf("expected the handler error, got %t %v", handled, err)
+	}
+
+	callback = &InteractionCallback{Type: InteractionTypeShortcut, CallbackID: "other"}
+	if handled, err := router.Handle(context.Background(), callback); handled || err != nil {
+		t.Errorf("expected no match, got %t %v", handled, err)
+	}
+}
+
+func TestInteractionRouterRegexpAlternation(t *testing.T) {
+	var got []string
+	router := NewInteractionRouter()
+	router.ActionRegexp(regexp.MustCompile(`approve|approve_all`), func(ctx context.Context, callback *InteractionCallback, match RouteMatch) error {
+		got = append(got, match.ID)
+		return nil
+	})
+
+	callback := &InteractionCallback{
+		Type: InteractionTypeBlockActions,
+		ActionCallback: ActionCallbacks{BlockActions: []*BlockAction{
+			{ActionID: "approve_all"},
+			{ActionID: "approve"},
+			{ActionID: "approve_some"},
+		}},
+	}
+	if _, err := router.Handle(context.Background(), callback); err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	want := []string{"approve_all", "approve"}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("expected %v, got %v", want, got)
+	}
+}
@@ -3,6 +3,7 @@ package slack
 import (
 	"encoding/json"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/assert"
 )
@@ -181,12 +182,12 @@ func TestViewClosedck(t *testing.T) {
 					NewSectionBlock(
 						NewTextBlockObject("mrkdwn", "*Sally* has requested you set the deadline for the Nano launch project", false, false),
 						nil,
-						NewAccessory(&DatePickerBlockElement{
+						&DatePickerBlockElement{
 							Type:        METDatepicker,
 							ActionID:    "datepicker123",
 							InitialDate: "1990-04-28",
 							Placeholder: NewTextBlockObject("plain_text", "Select a date", false, false),
-						}),
+						},
 					),
 				},
 			},
@@ -347,3 +348,401 @@ func TestInteractionCallbackJSONMarshalAndUnmarshal(t *testing.T) {
 	assert.Equal(t, cb.DialogSubmissionCallback.State,
 		jsonCB.DialogSubmissionCallback.State)
 }
+
+func TestViewSubmissionCallbackRadioButtons(t *testing.T) {
+	payload := `{
+		"type": "view_submission",
+		"view": {
+			"type": "modal",
+			"blocks": [
+				{
+					"type": "input",
+					"block_id": "size",
+					"label": {"type": "plain_text", "text": "Size"},
+					"element": {
+						"type": "radio_buttons",
+						"action_id": "size-value",
+						"options": [
+							{"text": {"type": "plain_text", "text": "Small"}, "value": "s"},
+							{"text": {"type": "plain_text", "text": "Large"}, "value": "l"}
+						],
+						"initial_option": {"text": {"type": "plain_text", "text": "Small"}, "value": "s"}
+					}
+				},
+				{
+					"type": "section",
+					"text": {"type": "mrkdwn", "text": "Crust"},
+					"accessory": {
+						"type": "radio_buttons",
+						"action_id": "crust-value",
+						"options": [{"text": {"type": "plain_text", "text": "Thin"}, "value": "thin"}]
+					}
+				}
+			],
+			"state": {
+				"values": {
+					"size": {
+						"size-value": {
+							"type": "radio_buttons",
+							"selected_option": {"text": {"type": "plain_text", "text": "Large"}, "value": "l"}
+						}
+					}
+				}
+			}
+		}
+	}`
+
+	var callback InteractionCallback
+	if err := json.Unmarshal([]byte(payload), &callback); err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	input := callback.View.Blocks.BlockSet[0].(*InputBlock)
+	radio, ok := input.Element.(*RadioButtonsBlockElement)
+	if !assert.True(t, ok, "expected a *RadioButtonsBlockElement, got %T", input.Element) {
+		return
+	}
+	assert.Len(t, radio.Options, 2)
+	assert.Equal(t, "s", radio.InitialOption.Value)
+
+	section := callback.View.Blocks.BlockSet[1].(*SectionBlock)
+	assert.IsType(t, &RadioButtonsBlockElement{}, section.Accessory)
+
+	assert.Equal(t, "l", callback.View.State.Values["size"]["size-value"].SelectedOption.Value)
+}
+
+func TestBlockActionsCallbackMultiStaticSelect(t *testing.T) {
+	payload := `{
+		"type": "block_actions",
+		"actions": [{
+			"type": "multi_static_select",
+			"action_id": "labels",
+			"block_id": "filters",
+			"selected_options": [
+				{"text": {"type": "plain_text", "text": "Bug"}, "value": "bug"},
+				{"text": {"type": "plain_text", "text": "Feature"}, "value": "feature"}
+			],
+			"action_ts": "1591012800.000100"
+		}]
+	}`
+
+	var callback InteractionCallback
+	if err := json.Unmarshal([]byte(payload), &callback); err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	if !assert.Len(t, callback.ActionCallback.BlockActions, 1) {
+		return
+	}
+	action := callback.ActionCallback.BlockActions[0]
+	assert.Equal(t, actionType(MultiOptTypeStatic), action.Type)
+	assert.Len(t, action.SelectedOptions, 2)
+	assert.Equal(t, "feature", action.SelectedOptions[1].Value)
+}
+
+func TestBlockActionsCallbackMultiUsersSelect(t *testing.T) {
+	payload := `{
+		"type": "block_actions",
+		"message": {
+			"type": "message",
+			"blocks": [{
+				"type": "section",
+				"text": {"type": "mrkdwn", "text": "Reviewers"},
+				"accessory": {
+					"type": "multi_users_select",
+					"action_id": "reviewers",
+					"initial_users": ["U1"],
+					"max_selected_items": 3
+				}
+			}]
+		},
+		"actions": [{
+			"type": "multi_users_select",
+			"action_id": "reviewers",
+			"block_id": "review",
+			"selected_users": ["U1", "U2"]
+		}]
+	}`
+
+	var callback InteractionCallback
+	if err := json.Unmarshal([]byte(payload), &callback); err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	section := callback.Message.Blocks.BlockSet[0].(*SectionBlock)
+	multiSelect, ok := section.Accessory.(*MultiSelectBlockElement)
+	if !assert.True(t, ok, "expected a *MultiSelectBlockElement, got %T", section.Accessory) {
+		return
+	}
+	assert.Equal(t, MultiOptTypeUser, multiSelect.Type)
+	assert.Equal(t, []string{"U1"}, multiSelect.InitialUsers)
+	assert.Equal(t, 3, *multiSelect.MaxSelectedItems)
+
+	if assert.Len(t, callback.ActionCallback.BlockActions, 1) {
+		assert.Equal(t, []string{"U1", "U2"}, callback.ActionCallback.BlockActions[0].SelectedUsers)
+	}
+}
+
+func TestBlockActionsCallbackUsersSelect(t *testing.T) {
+	payload := `{
+		"type": "block_actions",
+		"message": {
+			"type": "message",
+			"blocks": [{
+				"type": "section",
+				"text": {"type": "mrkdwn", "text": "Assignee"},
+				"accessory": {
+					"type": "users_select",
+					"action_id": "assignee",
+					"initial_user": "U1"
+				}
+			}, {
+				"type": "actions",
+				"elements": [{
+					"type": "users_select",
+					"action_id": "reviewer",
+					"placeholder": {"type": "plain_text", "text": "Reviewer"}
+				}]
+			}]
+		},
+		"actions": [{
+			"type": "users_select",
+			"action_id": "assignee",
+			"block_id": "assign",
+			"selected_user": "U2"
+		}]
+	}`
+
+	var callback InteractionCallback
+	if err := json.Unmarshal([]byte(payload), &callback); err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	section := callback.Message.Blocks.BlockSet[0].(*SectionBlock)
+	userSelect, ok := section.Accessory.(*SelectBlockElement)
+	if !assert.True(t, ok, "expected a *SelectBlockElement, got %T", section.Accessory) {
+		return
+	}
+	assert.Equal(t, OptTypeUser, userSelect.Type)
+	assert.Equal(t, "U1", userSelect.InitialUser)
+
+	actions := callback.Message.Blocks.BlockSet[1].(*ActionBlock)
+	if assert.Len(t, actions.Elements.ElementSet, 1) {
+		assert.Equal(t, MessageElementType(OptTypeUser), actions.Elements.ElementSet[0].ElementType())
+	}
+
+	if assert.Len(t, callback.ActionCallback.BlockActions, 1) {
+		assert.Equal(t, "U2", callback.ActionCallback.BlockActions[0].SelectedUser)
+	}
+}
+
+func TestViewSubmissionCallbackPlainTextInput(t *testing.T) {
+	payload := `{
+		"type": "view_submission",
+		"view": {
+			"type": "modal",
+			"blocks": [{
+				"type": "input",
+				"block_id": "description",
+				"label": {"type": "plain_text", "text": "Description"},
+				"element": {
+					"type": "plain_text_input",
+					"action_id": "description-value",
+					"placeholder": {"type": "plain_text", "text": "What happened?"},
+					"initial_value": "It broke",
+					"multiline": true,
+					"min_length": 5,
+					"max_length": 500
+				}
+			}],
+			"state": {
+				"values": {
+					"description": {
+						"description-value": {"type": "plain_text_input", "value": "It broke\nafter the deploy"}
+					}
+				}
+			}
+		}
+	}`
+
+	var callback InteractionCallback
+	if err := json.Unmarshal([]byte(payload), &callback); err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	input := callback.View.Blocks.BlockSet[0].(*InputBlock)
+	expected := NewPlainTextInputBlockElement(NewTextBlockObject(PlainTextType, "What happened?", false, false), "description-value")
+	expected.InitialValue = "It broke"
+	expected.Multiline = true
+	expected.MinLength = 5
+	expected.MaxLength = 500
+	assert.Equal(t, expected, input.Element)
+
+	assert.Equal(t, "It broke\nafter the deploy", callback.View.State.Values["description"]["description-value"].Value)
+}
+
+func TestViewSubmissionCallbackNumberInput(t *testing.T) {
+	payload := `{
+		"type": "view_submission",
+		"view": {
+			"type": "modal",
+			"state": {
+				"values": {
+					"order": {
+						"quantity": {"type": "number_input", "value": "3"},
+						"discount": {"type": "number_input", "value": "12.5"}
+					}
+				}
+			}
+		}
+	}`
+
+	var callback InteractionCallback
+	if err := json.Unmarshal([]byte(payload), &callback); err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	quantity, err := callback.View.State.Values["order"]["quantity"].IntValue()
+	if assert.NoError(t, err) {
+		assert.Equal(t, int64(3), quantity)
+	}
+	discount, err := callback.View.State.Values["order"]["discount"].FloatValue()
+	if assert.NoError(t, err) {
+		assert.Equal(t, 12.5, discount)
+	}
+	_, err = callback.View.State.Values["order"]["discount"].IntValue()
+	assert.Error(t, err)
+}
+
+func TestViewSubmissionCallbackEmailTextInput(t *testing.T) {
+	payload := `{
+		"type": "view_submission",
+		"view": {
+			"type": "modal",
+			"blocks": [{
+				"type": "input",
+				"block_id": "contact",
+				"label": {"type": "plain_text", "text": "Email"},
+				"element": {"type": "email_text_input", "action_id": "email"}
+			}],
+			"state": {
+				"values": {
+					"contact": {
+						"email": {"type": "email_text_input", "value": "ops@example.com"}
+					}
+				}
+			}
+		}
+	}`
+
+	var callback InteractionCallback
+	if err := json.Unmarshal([]byte(payload), &callback); err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	assert.IsType(t, &EmailTextInputBlockElement{}, callback.View.Blocks.BlockSet[0].(*InputBlock).Element)
+	action := callback.View.State.Values["contact"]["email"]
+	assert.Equal(t, actionType(METEmailTextInput), action.Type)
+	assert.Equal(t, "ops@example.com", action.Value)
+}
+
+func TestViewSubmissionCallbackURLTextInput(t *testing.T) {
+	payload := `{
+		"type": "view_submission",
+		"view": {
+			"type": "modal",
+			"blocks": [{
+				"type": "input",
+				"block_id": "resource",
+				"label": {"type": "plain_text", "text": "Link"},
+				"element": {"type": "url_text_input", "action_id": "link"}
+			}],
+			"state": {
+				"values": {
+					"resource": {
+						"link": {"type": "url_text_input", "value": "https://example.com/docs?page=2"},
+						"other": {"type": "url_text_input", "value": "example.com/docs"}
+					}
+				}
+			}
+		}
+	}`
+
+	var callback InteractionCallback
+	if err := json.Unmarshal([]byte(payload), &callback); err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	assert.IsType(t, &URLTextInputBlockElement{}, callback.View.Blocks.BlockSet[0].(*InputBlock).Element)
+	link, err := callback.View.State.Values["resource"]["link"].URLValue()
+	if assert.NoError(t, err) {
+		assert.Equal(t, "example.com", link.Host)
+		assert.Equal(t, "/docs", link.Path)
+	}
+	_, err = callback.View.State.Values["resource"]["other"].URLValue()
+	assert.Equal(t, ErrInvalidURL, err)
+}
+
+func TestBlockActionsCallbackDateTimePicker(t *testing.T) {
+	payload := `{
+		"type": "block_actions",
+		"actions": [{"type": "datetimepicker", "action_id": "when", "block_id": "schedule", "selected_date_time": 1614850215}]
+	}`
+
+	var callback InteractionCallback
+	if err := json.Unmarshal([]byte(payload), &callback); err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	action := callback.ActionCallback.BlockActions[0]
+	assert.Equal(t, actionType(METDatetimepicker), action.Type)
+	assert.Equal(t, time.Date(2021, 3, 4, 9, 30, 15, 0, time.UTC), action.SelectedDateTimeAsTime().UTC())
+	assert.True(t, BlockAction{}.SelectedDateTimeAsTime().IsZero())
+}
+
+func TestBlockActionsCallbackTimePicker(t *testing.T) {
+	payload := `{
+		"type": "block_actions",
+		"actions": [{"type": "timepicker", "action_id": "at", "block_id": "standup", "selected_time": "10:15", "initial_time": "09:30"}]
+	}`
+
+	var callback InteractionCallback
+	if err := json.Unmarshal([]byte(payload), &callback); err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	action := callback.ActionCallback.BlockActions[0]
+	assert.Equal(t, actionType(METTimepicker), action.Type)
+	assert.Equal(t, "10:15", action.SelectedTime)
+	assert.Equal(t, "09:30", action.InitialTime)
+}
+
+func TestBlockActionsCallbackDispatchedInput(t *testing.T) {
+	payload := `{
+		"type": "block_actions",
+		"container": {"type": "message", "message_ts": "1591012800.000100", "channel_id": "C1"},
+		"actions": [
+			{"type": "plain_text_input", "action_id": "search", "block_id": "query", "value": "outage", "action_ts": "1591012801.000100"},
+			{"type": "number_input", "action_id": "limit", "block_id": "count", "value": "25", "action_ts": "1591012802.000100"}
+		]
+	}`
+
+	var callback InteractionCallback
+	if err := json.Unmarshal([]byte(payload), &callback); err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	if !assert.Len(t, callback.ActionCallback.BlockActions, 2) {
+		return
+	}
+	search := callback.ActionCallback.BlockActions[0]
+	assert.Equal(t, actionType(METPlainTextInput), search.Type)
+	assert.Equal(t, "query", search.BlockID)
+	assert.Equal(t, "outage", search.Value)
+
+	limit := callback.ActionCallback.BlockActions[1]
+	assert.Equal(t, actionType(METNumber), limit.Type)
+	count, err := limit.IntValue()
+	assert.NoError(t, err)
+	assert.Equal(t, int64(25), count)
+}
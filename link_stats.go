@@ -0,0 +1,111 @@
+package slack
+
+import (
+	"context"
+	"net/url"
+	"regexp"
+	"sort"
+	"strings"
+	"sync"
+)
+
+// linkPattern matches the links of message text, e.g. <https://example.com|label>.
+var linkPattern = regexp.MustCompile(`<(https?://[^|>\s]+)(?:\|[^>]*)?>`)
+
+// LinkCount is the number of links shared for a domain or by a user.
+type LinkCount struct {
+	Name  string `json:"name"`
+	Links int    `json:"links"`
+}
+
+// LinkReport aggregates the links shared in channels.
+type LinkReport struct {
+	// Messages is the number of messages scanned.
+	Messages int `json:"messages"`
+	Links    int `json:"links"`
+	// Domains are the hosts of the links without their www. prefix, most shared first.
+	Domains []LinkCount `json:"domains"`
+	// Sharers are the IDs of the users, or bots, who shared the links, most links first.
+	Sharers []LinkCount `json:"sharers"`
+}
+
+// LinkStats scans the history of the channels for links and counts them by domain and by
+// sharer. A link appearing several times in a message is counted once. The history is
+// fetched by Backfill, with checkpoints only the messages posted since the previous run are scanned.
+func (api *Client) LinkStats(params BackfillParameters) (LinkReport, error) {
+	return api.LinkStatsContext(context.Background(), params)
+}
+
+// LinkStatsContext scans the history of the channels for links with a custom context.
+// For more details, see LinkStats documentation.
+func (api *Client) LinkStatsContext(ctx context.Context, params BackfillParameters) (LinkReport, error) {
+	var (
+		mu       sync.Mutex
+		messages int
+		links    int
+		domains  = map[string]int{}
+		sharers  = map[string]int{}
+	)
+	err := api.BackfillContext(ctx, params, func(ctx context.Context, channelID string, msgs []Message) error {
+		mu.Lock()
+		defer mu.Unlock()
+
+		for _, msg := range msgs {
+			messages++
+			sharer := msg.User
+			if sharer == "" {
+				sharer = msg.BotID
+			}
+			for _, link := range MessageLinks(msg.Text) {
+				u, err := url.Parse(link)
+				if err != nil {
+					continue
+				}
+				links++
+				domains[strings.TrimPrefix(strings.ToLower(u.Hostname()), "www.")]++
+				if sharer != "" {
+					sharers[sharer]++
+				}
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		return LinkReport{}, err
+	}
+
+	return LinkReport{
+		Messages: messages,
+		Links:    links,
+		Domains:  sortedLinkCounts(domains),
+		Sharers:  sortedLinkCounts(sharers),
+	}, nil
+}
+
+// MessageLinks returns the distinct http and https links of the text of a message, in order.
+func MessageLinks(text string) []string {
+	var links []string
+	seen := map[string]bool{}
+	for _, match := range linkPattern.FindAllStringSubmatch(text, -1) {
+		if !seen[match[1]] {
+			seen[match[1]] = true
+			links = append(links, match[1])
+		}
+	}
+	return links
+}
+
+// sortedLinkCounts sorts the counts by decreasing number of links, then by name.
+func sortedLinkCounts(counts map[string]int) []LinkCount {
+	sorted := make([]LinkCount, 0, len(counts))
+	for name, links := range counts {
+		sorted = append(sorted, LinkCount{Name: name, Links: links})
+	}
+	sort.Slice(sorted, func(i, j int) bool {
+		if sorted[i].Links != sorted[j].Links {
+			return sorted[i].Links > sorted[j].Links
+		}
+		return sorted[i].Name < sorted[j].Name
+	})
+	return sorted
+}
@@ -0,0 +1,51 @@
+package slack
+
+import (
+	"net/http"
+	"reflect"
+	"testing"
+)
+
+func TestMessageLinks(t *testing.T) {
+	links := MessageLinks("see <https://example.com/a|the doc>, <mailto:a@example.com|mail>, <@U1> and <http://example.com/b> <https://example.com/a>")
+	expected := []string{"https://example.com/a", "http://example.com/b"}
+	if !reflect.DeepEqual(links, expected) {
+		t.Errorf("expected %v, got %v", expected, links)
+	}
+}
+
+func TestLinkStats(t *testing.T) {
+	http.DefaultServeMux = new(http.ServeMux)
+	defer func() { http.DefaultServeMux = new(http.ServeMux) }()
+
+	http.HandleFunc("/conversations.history", func(rw http.ResponseWriter, r *http.Request) {
+		rw.Header().Set("Content-Type", "application/json")
+		switch r.FormValue("channel") {
+		case "C1":
+			rw.Write([]byte(`{"ok": true, "messages": [
+				{"ts": "3", "user": "U1", "text": "<https://www.Example.com/a> and <https://docs.example.org/b|docs>"},
+				{"ts": "2", "user": "U2", "text": "no links"},
+				{"ts": "1", "bot_id": "B1", "text": "<https://example.com/c>"}
+			]}`))
+		case "C2":
+			rw.Write([]byte(`{"ok": true, "messages": [{"ts": "1", "user": "U2", "text": "<https://example.com/a>"}]}`))
+		}
+	})
+	once.Do(startServer)
+	api := New(validToken, OptionAPIURL("http://"+serverAddr+"/"))
+
+	report, err := api.LinkStats(BackfillParameters{Channels: []string{"C1", "C2"}})
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	expected := LinkReport{
+		Messages: 4,
+		Links:    4,
+		Domains:  []LinkCount{{Name: "example.com", Links: 3}, {Name: "docs.example.org", Links: 1}},
+		Sharers:  []LinkCount{{Name: "U1", Links: 2}, {Name: "B1", Links: 1}, {Name: "U2", Links: 1}},
+	}
+	if !reflect.DeepEqual(report, expected) {
+		t.Errorf("expected %#v, got %#v", expected, report)
+	}
+}
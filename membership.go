@@ -0,0 +1,173 @@
+package slack
+
+import (
+	"context"
+	"sort"
+	"sync"
+	"time"
+)
+
+// MembershipSnapshot is the members of channels at a point in time. It can be encoded as JSON
+// to be compared with the snapshot of the next run, e.g. for periodic access reviews.
+type MembershipSnapshot struct {
+	TakenAt time.Time `json:"taken_at"`
+	// Members are the sorted IDs of the members of each channel, by channel ID.
+	Members map[string][]string `json:"members"`
+}
+
+// MembershipChangeType is the kind of a MembershipChange.
+type MembershipChangeType string
+
+const (
+	MembershipJoined MembershipChangeType = "joined"
+	MembershipLeft   MembershipChangeType = "left"
+)
+
+// MembershipChange is a user who joined or left a channel between two snapshots.
+type MembershipChange struct {
+	Channel string               `json:"channel"`
+	User    string               `json:"user"`
+	Type    MembershipChangeType `json:"type"`
+}
+
+// DiffMembership returns the users who joined or left the channels between the snapshots, sorted
+// by channel then user. Channels missing from either snapshot aren't compared.
+func DiffMembership(before, after MembershipSnapshot) []MembershipChange {
+	var changes []MembershipChange
+	for channel, members := range after.Members {
+		previous, ok := before.Members[channel]
+		if !ok {
+			continue
+		}
+
+		for _, user := range missingMembers(members, previous) {
+			changes = append(changes, MembershipChange{Channel: channel, User: user, Type: MembershipJoined})
+		}
+		for _, user := range missingMembers(previous, members) {
+			changes = append(changes, MembershipChange{Channel: channel, User: user, Type: MembershipLeft})
+		}
+	}
+
+	sort.Slice(changes, func(i, j int) bool {
+		if changes[i].Channel != changes[j].Channel {
+			return changes[i].Channel < changes[j].Channel
+		}
+		return changes[i].User < changes[j].User
+	})
+	return changes
+}
+
+// missingMembers returns the members of a which aren't in b.
+func missingMembers(a, b []string) []string {
+	in := make(map[string]bool, len(b))
+	for _, user := range b {
+		in[user] = true
+	}
+
+	var missing []string
+	for _, user := range a {
+		if !in[user] {
+			missing = append(missing, user)
+		}
+	}
+	return missing
+}
+
+// MembershipCache caches the members of channels, paging through conversations.members when
+// a channel isn't cached or its entry is older than the TTL. It's safe for concurrent use.
+type MembershipCache struct {
+	client *Client
+	ttl    time.Duration
+
+	mu      sync.Mutex
+	entries map[string]membershipEntry
+}
+
+type membershipEntry struct {
+	members   []string
+	fetchedAt time.Time
+}
+
+// NewMembershipCache creates a cache whose entries expire after ttl, a zero ttl disables the caching.
+func NewMembershipCache(client *Client, ttl time.Duration) *MembershipCache {
+	return &MembershipCache{
+		client:  client,
+		ttl:     ttl,
+		entries: map[string]membershipEntry{},
+	}
+}
+
+// Members returns the sorted IDs of the members of the channel.
+func (c *MembershipCache) Members(ctx context.Context, channelID string) ([]string, error) {
+	clock := c.client.currentClock()
+
+	c.mu.Lock()
+	entry, ok := c.entries[channelID]
+	c.mu.Unlock()
+	if ok && clock.Now().Sub(entry.fetchedAt) < c.ttl {
+		return entry.members, nil
+	}
+
+	fetchedAt := clock.Now()
+	members, err := c.fetch(ctx, channelID)
+	if err != nil {
+		return nil, err
+	}
+
+	c.mu.Lock()
+	c.entries[channelID] = membershipEntry{members: members, fetchedAt: fetchedAt}
+	c.mu.Unlock()
+
+	return members, nil
+}
+
+// Invalidate drops the cached members of the channel, e.g. on a member_joined_channel event.
+func (c *MembershipCache) Invalidate(channelID string) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	delete(c.entries, channelID)
+}
+
+// Snapshot returns the members of the channels.
+func (c *MembershipCache) Snapshot(ctx context.Context, channelIDs ...string) (MembershipSnapshot, error) {
+	snapshot := MembershipSnapshot{
+		TakenAt: c.client.currentClock().Now(),
+		Members: make(map[string][]string, len(channelIDs)),
+	}
+
+	for _, channelID := range channelIDs {
+		members, err := c.Members(ctx, channelID)
+		if err != nil {
+			return MembershipSnapshot{}, err
+		}
+		snapshot.Members[channelID] = members
+	}
+
+	return snapshot, nil
+}
+
+func (c *MembershipCache) fetch(ctx context.Context, channelID string) ([]string, error) {
+	params := GetUsersInConversationParameters{ChannelID: channelID, Limit: 1000}
+
+	var members []string
+	for {
+		var (
+			page   []string
+			cursor string
+		)
+		err := retryRateLimited(ctx, c.client.currentClock(), func() (err error) {
+			page, cursor, err = c.client.GetUsersInConversationContext(ctx, &params)
+			return err
+		})
+		if err != nil {
+			return nil, err
+		}
+
+		members = append(members, page...)
+		if cursor == "" {
+			sort.Strings(members)
+			return members, nil
+		}
+		params.Cursor = cursor
+	}
+}
@@ -0,0 +1,82 @@
+package slack
+
+import (
+	"context"
+	"net/http"
+	"reflect"
+	"testing"
+	"time"
+)
+
+func TestMembershipCache(t *testing.T) {
+	http.DefaultServeMux = new(http.ServeMux)
+	defer func() { http.DefaultServeMux = new(http.ServeMux) }()
+
+	calls := 0
+	members := `["U3", "U1"]`
+	http.HandleFunc("/conversations.members", func(rw http.ResponseWriter, r *http.Request) {
+		calls++
+		rw.Header().Set("Content-Type", "application/json")
+		if r.FormValue("cursor") == "" {
+			rw.Write([]byte(`{"ok": true, "members": ` + members + `, "response_metadata": {"next_cursor": "page2"}}`))
+			return
+		}
+		rw.Write([]byte(`{"ok": true, "members": ["U2"], "response_metadata": {"next_cursor": ""}}`))
+	})
+
+	once.Do(startServer)
+	clock := NewManualClock(time.Date(2020, 1, 1, 12, 0, 0, 0, time.UTC))
+	api := New("testing-token", OptionAPIURL("http://"+serverAddr+"/"), OptionClock(clock))
+	cache := NewMembershipCache(api, time.Hour)
+
+	before, err := cache.Snapshot(context.Background(), "C1")
+	if err != nil {
+		t.Fatalf("Unexpected error: %s", err)
+	}
+	if !reflect.DeepEqual(before.Members["C1"], []string{"U1", "U2", "U3"}) || calls != 2 {
+		t.Fatalf("Unexpected snapshot %#v after %d calls", before, calls)
+	}
+
+	members = `["U4", "U1"]`
+	if _, err := cache.Snapshot(context.Background(), "C1"); err != nil || calls != 2 {
+		t.Fatalf("Expected the members to be cached, got %d calls, error %v", calls, err)
+	}
+
+	clock.Advance(time.Hour)
+	after, err := cache.Snapshot(context.Background(), "C1")
+	if err != nil {
+		t.Fatalf("Unexpected error: %s", err)
+	}
+	if calls != 4 {
+		t.Errorf("Expected the members to be fetched again, got %d calls", calls)
+	}
+
+	expected := []MembershipChange{
+		{Channel: "C1", User: "U3", Type: MembershipLeft},
+		{Channel: "C1", User: "U4", Type: MembershipJoined},
+	}
+	if changes := DiffMembership(before, after); !reflect.DeepEqual(changes, expected) {
+		t.Errorf("Unexpected changes: %#v", changes)
+	}
+}
+
+func TestDiffMembership(t *testing.T) {
+	before := MembershipSnapshot{Members: map[string][]string{
+		"C1": {"U1", "U2"},
+		"C2": {"U1"},
+		"C3": {"U1"},
+	}}
+	after := MembershipSnapshot{Members: map[string][]string{
+		"C1": {"U2", "U3"},
+		"C2": {"U1"},
+		"C4": {"U1"},
+	}}
+
+	expected := []MembershipChange{
+		{Channel: "C1", User: "U1", Type: MembershipLeft},
+		{Channel: "C1", User: "U3", Type: MembershipJoined},
+	}
+	if changes := DiffMembership(before, after); !reflect.DeepEqual(changes, expected) {
+		t.Errorf("Unexpected changes: %#v", changes)
+	}
+}
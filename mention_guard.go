@@ -0,0 +1,102 @@
+package slack
+
+import (
+	"encoding/json"
+	"regexp"
+)
+
+// BroadcastGuard controls how the client handles broadcast mentions, <!here>, <!channel>
+// and <!everyone>, found in outgoing messages.
+type BroadcastGuard int
+
+const (
+	// BroadcastAllow sends broadcast mentions as is, this is the default.
+	BroadcastAllow BroadcastGuard = iota
+	// BroadcastReject fails the call with ErrBroadcastMention.
+	BroadcastReject
+	// BroadcastStrip removes the broadcast mentions before sending the message.
+	BroadcastStrip
+)
+
+// broadcastPattern matches broadcast mentions, with an optional label, e.g. <!here|@here>,
+// in plain text as well as in JSON where encoding/json escapes the angle brackets.
+var broadcastPattern = regexp.MustCompile(`(?:<|\\u003c)!(?:here|channel|everyone)(?:\|[^>\\]*)?(?:>|\\u003e)`)
+
+// OptionBroadcastGuard sets how broadcast mentions in the text, blocks and attachments of
+// outgoing messages are handled, protecting automated senders from accidentally pinging
+// everyone in a channel. Use MsgOptionAllowBroadcast to allow them for a given call.
+func OptionBroadcastGuard(mode BroadcastGuard) func(*Client) {
+	return func(c *Client) {
+		c.broadcastGuard = mode
+	}
+}
+
+// MsgOptionAllowBroadcast allows broadcast mentions in the message regardless of OptionBroadcastGuard.
+func MsgOptionAllowBroadcast() MsgOption {
+	return func(config *sendConfig) error {
+		config.allowBroadcast = true
+		return nil
+	}
+}
+
+// msgOptionBroadcastGuard applies the guard to the message, it must be the last option applied.
+func msgOptionBroadcastGuard(mode BroadcastGuard) MsgOption {
+	return func(config *sendConfig) error {
+		if mode == BroadcastAllow || config.allowBroadcast {
+			return nil
+		}
+
+		for _, key := range []string{"text", "blocks", "attachments"} {
+			values, ok := config.values[key]
+			if !ok {
+				continue
+			}
+			for i, value := range values {
+				guarded, err := guardBroadcast(mode, value)
+				if err != nil {
+					return err
+				}
+				values[i] = guarded
+			}
+		}
+
+		// messages sent to a response url are built from the blocks and attachments themselves.
+		if len(config.blocks.BlockSet) > 0 {
+			if err := guardBroadcastJSON(mode, &config.blocks); err != nil {
+				return err
+			}
+		}
+		if len(config.attachments) > 0 {
+			if err := guardBroadcastJSON(mode, &config.attachments); err != nil {
+				return err
+			}
+		}
+
+		return nil
+	}
+}
+
+func guardBroadcast(mode BroadcastGuard, s string) (string, error) {
+	if !broadcastPattern.MatchString(s) {
+		return s, nil
+	}
+	if mode == BroadcastReject {
+		return "", ErrBroadcastMention
+	}
+	return broadcastPattern.ReplaceAllString(s, ""), nil
+}
+
+// guardBroadcastJSON applies the guard to the JSON encoding of v, decoding the result back into v when stripped.
+func guardBroadcastJSON(mode BroadcastGuard, v interface{}) error {
+	b, err := json.Marshal(v)
+	if err != nil {
+		return err
+	}
+
+	guarded, err := guardBroadcast(mode, string(b))
+	if err != nil || guarded == string(b) {
+		return err
+	}
+
+	return json.Unmarshal([]byte(guarded), v)
+}
@@ -0,0 +1,93 @@
+package slack
+
+import (
+	"net/http"
+	"testing"
+)
+
+func TestMsgOptionBroadcastGuard(t *testing.T) {
+	block := NewSectionBlock(NewTextBlockObject(MarkdownType, "deploy done <!channel>", false, false), nil, nil)
+
+	tests := []struct {
+		name    string
+		mode    BroadcastGuard
+		options []MsgOption
+		text    string
+		blocks  string
+		err     error
+	}{
+		{
+			name:    "allow",
+			mode:    BroadcastAllow,
+			options: []MsgOption{MsgOptionText("<!here> deploy done", false)},
+			text:    "<!here> deploy done",
+		},
+		{
+			name:    "reject",
+			mode:    BroadcastReject,
+			options: []MsgOption{MsgOptionText("<!here|@here> deploy done", false)},
+			err:     ErrBroadcastMention,
+		},
+		{
+			name:    "reject blocks",
+			mode:    BroadcastReject,
+			options: []MsgOption{MsgOptionBlocks(block)},
+			err:     ErrBroadcastMention,
+		},
+		{
+			name:    "allowed per call",
+			mode:    BroadcastReject,
+			options: []MsgOption{MsgOptionText("<!everyone> deploy done", false), MsgOptionAllowBroadcast()},
+			text:    "<!everyone> deploy done",
+		},
+		{
+			name:    "strip",
+			mode:    BroadcastStrip,
+			options: []MsgOption{MsgOptionText("<!here>deploy done, ping <@U123>", false), MsgOptionBlocks(block)},
+			text:    "deploy done, ping <@U123>",
+			blocks:  `[{"type":"section","text":{"type":"mrkdwn","text":"deploy done "}}]`,
+		},
+	}
+
+	for _, test := range tests {
+		options := append(test.options, msgOptionBroadcastGuard(test.mode))
+		_, values, err := UnsafeApplyMsgOptions("token", "C123", "https://slack.com/api/", options...)
+		if err != test.err {
+			t.Errorf("%s: expected error %v, got %v", test.name, test.err, err)
+			continue
+		}
+		if err != nil {
+			continue
+		}
+		if got := values.Get("text"); got != test.text {
+			t.Errorf("%s: expected text %q, got %q", test.name, test.text, got)
+		}
+		if got := values.Get("blocks"); test.blocks != "" && got != test.blocks {
+			t.Errorf("%s: expected blocks %s, got %s", test.name, test.blocks, got)
+		}
+	}
+}
+
+func TestOptionBroadcastGuard(t *testing.T) {
+	http.DefaultServeMux = new(http.ServeMux)
+	defer func() { http.DefaultServeMux = new(http.ServeMux) }()
+
+	var texts []string
+	http.HandleFunc("/chat.postMessage", func(rw http.ResponseWriter, r *http.Request) {
+		texts = append(texts, r.FormValue("text"))
+		rw.Header().Set("Content-Type", "application/json")
+		rw.Write([]byte(`{"ok": true, "channel": "C123", "ts": "1234567890.000100"}`))
+	})
+	once.Do(startServer)
+	api := New(validToken, OptionAPIURL("http://"+serverAddr+"/"), OptionBroadcastGuard(BroadcastReject))
+
+	if _, _, err := api.PostMessage("C123", MsgOptionText("<!channel> lunch", false)); err != ErrBroadcastMention {
+		t.Fatalf("expected %v, got %v", ErrBroadcastMention, err)
+	}
+	if _, _, err := api.PostMessage("C123", MsgOptionText("<!channel> lunch", false), MsgOptionAllowBroadcast()); err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	if len(texts) != 1 || texts[0] != "<!channel> lunch" {
+		t.Errorf("unexpected messages sent: %v", texts)
+	}
+}
@@ -0,0 +1,192 @@
+package slack
+
+import (
+	"context"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+)
+
+// MessageStatsParameters contains arguments for MessageStats method call
+type MessageStatsParameters struct {
+	BackfillParameters
+	// Location is the timezone of the days messages are counted in, UTC when nil.
+	Location *time.Location
+}
+
+// UserMessageStats is the activity of a user, or a bot, in the channels.
+type UserMessageStats struct {
+	User     string `json:"user"`
+	Messages int    `json:"messages"`
+	// Threads is the number of threads the user replied to. It relies on the reply_users of the
+	// messages, which slack truncates for threads with many participants, so it's a lower bound.
+	Threads int `json:"threads"`
+	// ReactionsReceived is the number of reactions added to the messages of the user.
+	ReactionsReceived int `json:"reactions_received"`
+}
+
+// DayMessageStats is the number of messages posted during a day, as YYYY-MM-DD.
+type DayMessageStats struct {
+	Day      string `json:"day"`
+	Messages int    `json:"messages"`
+}
+
+// ReactionStats is the number of times a reaction was added to messages.
+type ReactionStats struct {
+	Name  string `json:"name"`
+	Count int    `json:"count"`
+}
+
+// MessageStatsReport aggregates the activity of channels.
+type MessageStatsReport struct {
+	// Messages is the number of messages posted to the channels, replies in threads excluded.
+	Messages int `json:"messages"`
+	// Threads is the number of messages which have replies, and Replies the number of replies.
+	Threads   int `json:"threads"`
+	Replies   int `json:"replies"`
+	Reactions int `json:"reactions"`
+	// Users are sorted by decreasing number of messages, then by ID.
+	Users []UserMessageStats `json:"users"`
+	// Days are the days with messages, in chronological order.
+	Days []DayMessageStats `json:"days"`
+	// ReactionNames are sorted by decreasing count, then by name.
+	ReactionNames []ReactionStats `json:"reaction_names"`
+}
+
+// MessageStats counts the messages of the channels per user and per day, along with the
+// participation in threads and the reactions. The history is fetched by Backfill, with
+// checkpoints only the messages posted since the previous run are counted. The replies
+// themselves aren't fetched, the participation in threads comes from the thread messages.
+func (api *Client) MessageStats(params MessageStatsParameters) (MessageStatsReport, error) {
+	return api.MessageStatsContext(context.Background(), params)
+}
+
+// MessageStatsContext counts the messages of the channels with a custom context.
+// For more details, see MessageStats documentation.
+func (api *Client) MessageStatsContext(ctx context.Context, params MessageStatsParameters) (MessageStatsReport, error) {
+	loc := params.Location
+	if loc == nil {
+		loc = time.UTC
+	}
+
+	// Latest bounds the history fetched, it's also checked here since a run resumed from
+	// a checkpoint keeps the bound it started with.
+	var latest time.Time
+	if params.Latest != "" {
+		var err error
+		if latest, err = timestampTime(params.Latest); err != nil {
+			return MessageStatsReport{}, err
+		}
+	}
+
+	var (
+		mu        sync.Mutex
+		report    MessageStatsReport
+		users     = map[string]*UserMessageStats{}
+		days      = map[string]int{}
+		reactions = map[string]int{}
+	)
+	user := func(id string) *UserMessageStats {
+		stats, ok := users[id]
+		if !ok {
+			stats = &UserMessageStats{User: id}
+			users[id] = stats
+		}
+		return stats
+	}
+
+	err := api.BackfillContext(ctx, params.BackfillParameters, func(ctx context.Context, channelID string, msgs []Message) error {
+		mu.Lock()
+		defer mu.Unlock()
+
+		for _, msg := range msgs {
+			posted, err := timestampTime(msg.Timestamp)
+			if err != nil || (!latest.IsZero() && posted.After(latest)) {
+				continue
+			}
+
+			report.Messages++
+			days[posted.In(loc).Format("2006-01-02")]++
+
+			author := msg.User
+			if author == "" {
+				author = msg.BotID
+			}
+			if author != "" {
+				user(author).Messages++
+			}
+
+			if msg.ReplyCount > 0 {
+				report.Threads++
+				report.Replies += msg.ReplyCount
+				for _, id := range msg.ReplyUsers {
+					user(id).Threads++
+				}
+			}
+
+			for _, reaction := range msg.Reactions {
+				report.Reactions += reaction.Count
+				reactions[reaction.Name] += reaction.Count
+				if author != "" {
+					user(author).ReactionsReceived += reaction.Count
+				}
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		return MessageStatsReport{}, err
+	}
+
+	report.Users = make([]UserMessageStats, 0, len(users))
+	for _, stats := range users {
+		report.Users = append(report.Users, *stats)
+	}
+	sort.Slice(report.Users, func(i, j int) bool {
+		if report.Users[i].Messages != report.Users[j].Messages {
+			return report.Users[i].Messages > report.Users[j].Messages
+		}
+		return report.Users[i].User < report.Users[j].User
+	})
+
+	report.Days = make([]DayMessageStats, 0, len(days))
+	for day, messages := range days {
+		report.Days = append(report.Days, DayMessageStats{Day: day, Messages: messages})
+	}
+	sort.Slice(report.Days, func(i, j int) bool {
+		return report.Days[i].Day < report.Days[j].Day
+	})
+
+	report.ReactionNames = make([]ReactionStats, 0, len(reactions))
+	for name, count := range reactions {
+		report.ReactionNames = append(report.ReactionNames, ReactionStats{Name: name, Count: count})
+	}
+	sort.Slice(report.ReactionNames, func(i, j int) bool {
+		if report.ReactionNames[i].Count != report.ReactionNames[j].Count {
+			return report.ReactionNames[i].Count > report.ReactionNames[j].Count
+		}
+		return report.ReactionNames[i].Name < report.ReactionNames[j].Name
+	})
+
+	return report, nil
+}
+
+// timestampTime converts the timestamp of a message, e.g. "1591012800.000100", into a time.
+func timestampTime(ts string) (time.Time, error) {
+	sec, usec := ts, "0"
+	if i := strings.IndexByte(ts, '.'); i >= 0 {
+		sec, usec = ts[:i], ts[i+1:]
+	}
+
+	s, err := strconv.ParseInt(sec, 10, 64)
+	if err != nil {
+		return time.Time{}, err
+	}
+	us, err := strconv.ParseInt(usec, 10, 64)
+	if err != nil {
+		return time.Time{}, err
+	}
+	return time.Unix(s, us*int64(time.Microsecond)), nil
+}
@@ -0,0 +1,68 @@
+package slack
+
+import (
+	"net/http"
+	"reflect"
+	"testing"
+	"time"
+)
+
+func TestMessageStats(t *testing.T) {
+	http.DefaultServeMux = new(http.ServeMux)
+	defer func() { http.DefaultServeMux = new(http.ServeMux) }()
+
+	var latest []string
+	http.HandleFunc("/conversations.history", func(rw http.ResponseWriter, r *http.Request) {
+		latest = append(latest, r.FormValue("latest"))
+		rw.Header().Set("Content-Type", "application/json")
+		switch r.FormValue("channel") {
+		case "C1":
+			rw.Write([]byte(`{"ok": true, "messages": [
+				{"ts": "1591200000.000100", "user": "U1", "text": "too late"},
+				{"ts": "1591020000.000200", "user": "U1", "text": "deploying", "reply_count": 3, "reply_users": ["U2", "U3"],
+					"reactions": [{"name": "rocket", "count": 2, "users": ["U2", "U3"]}, {"name": "eyes", "count": 1, "users": ["U2"]}]},
+				{"ts": "1591012800.000100", "user": "U2", "text": "morning"}
+			]}`))
+		case "C2":
+			rw.Write([]byte(`{"ok": true, "messages": [
+				{"ts": "1590969600.000100", "bot_id": "B1", "text": "nightly build passed",
+					"reactions": [{"name": "rocket", "count": 1, "users": ["U1"]}]}
+			]}`))
+		}
+	})
+	once.Do(startServer)
+	api := New(validToken, OptionAPIURL("http://"+serverAddr+"/"))
+
+	report, err := api.MessageStats(MessageStatsParameters{
+		BackfillParameters: BackfillParameters{Channels: []string{"C1", "C2"}, Latest: "1591100000.000000", Concurrency: 1},
+		Location:           time.FixedZone("UTC-8", -8*60*60),
+	})
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	if !reflect.DeepEqual(latest, []string{"1591100000.000000", "1591100000.000000"}) {
+		t.Errorf("expected the history to be fetched up to latest, got %v", latest)
+	}
+
+	expected := MessageStatsReport{
+		Messages:  3,
+		Threads:   1,
+		Replies:   3,
+		Reactions: 4,
+		Users: []UserMessageStats{
+			{User: "B1", Messages: 1, ReactionsReceived: 1},
+			{User: "U1", Messages: 1, ReactionsReceived: 3},
+			{User: "U2", Messages: 1, Threads: 1},
+			{User: "U3", Threads: 1},
+		},
+		Days: []DayMessageStats{
+			{Day: "2020-05-31", Messages: 1},
+			{Day: "2020-06-01", Messages: 2},
+		},
+		ReactionNames: []ReactionStats{{Name: "rocket", Count: 3}, {Name: "eyes", Count: 1}},
+	}
+	if !reflect.DeepEqual(report, expected) {
+		t.Errorf("expected %#v, got %#v", expected, report)
+	}
+}
@@ -1,5 +1,7 @@
 package slack
 
+import "encoding/json"
+
 // OutgoingMessage is used for the realtime API, and seems incomplete.
 type OutgoingMessage struct {
 	ID int `json:"id"`
@@ -70,6 +72,8 @@ type Msg struct {
 	ReplyCount   int     `json:"reply_count,omitempty"`
 	Replies      []Reply `json:"replies,omitempty"`
 	ParentUserId string  `json:"parent_user_id,omitempty"`
+	// ReplyUsers are the IDs of the users who replied to the thread.
+	ReplyUsers []string `json:"reply_users,omitempty"`
 
 	// file_share, file_comment, file_mention
 	Files []File `json:"files,omitempty"`
@@ -99,6 +103,44 @@ type Msg struct {
 	Blocks Blocks `json:"blocks,omitempty"`
 }
 
+// msgFields is Msg without its methods, so it can be embedded when marshalling.
+type msgFields Msg
+
+// optionalBlocks returns nil when there are no blocks, so the field is omitted.
+func (t Msg) optionalBlocks() *Blocks {
+	if len(t.Blocks.BlockSet) == 0 {
+		return nil
+	}
+	return &t.Blocks
+}
+
+// MarshalJSON omits the blocks field when the message has no blocks, slack rejects
+// "blocks": null in some contexts.
+func (t Msg) MarshalJSON() ([]byte, error) {
+	return json.Marshal(struct {
+		msgFields
+		Blocks *Blocks `json:"blocks,omitempty"`
+	}{
+		msgFields: msgFields(t),
+		Blocks:    t.optionalBlocks(),
+	})
+}
+
+// MarshalJSON omits the blocks field when the message has no blocks, see Msg.MarshalJSON.
+func (t Message) MarshalJSON() ([]byte, error) {
+	return json.Marshal(struct {
+		msgFields
+		Blocks          *Blocks `json:"blocks,omitempty"`
+		SubMessage      *Msg    `json:"message,omitempty"`
+		PreviousMessage *Msg    `json:"previous_message,omitempty"`
+	}{
+		msgFields:       msgFields(t.Msg),
+		Blocks:          t.Msg.optionalBlocks(),
+		SubMessage:      t.SubMessage,
+		PreviousMessage: t.PreviousMessage,
+	})
+}
+
 const (
 	// ResponseTypeInChannel in channel response for slash commands.
 	ResponseTypeInChannel = "in_channel"
@@ -3,6 +3,7 @@ package slack
 import (
 	"encoding/json"
 	"fmt"
+	"strings"
 	"testing"
 
 	"github.com/stretchr/testify/assert"
@@ -812,3 +813,40 @@ func TestFileShareMessage(t *testing.T) {
 	assert.True(t, message.Upload)
 	assert.NotNil(t, message.Files[0])
 }
+
+func TestMsgMarshalOmitsEmptyBlocks(t *testing.T) {
+	encoded, err := json.Marshal(Msg{Text: "hello"})
+	if err != nil {
+		t.Fatalf("Unexpected error: %s", err)
+	}
+	if strings.Contains(string(encoded), "blocks") {
+		t.Errorf("Expected the blocks field to be omitted, got %s", encoded)
+	}
+
+	encoded, err = json.Marshal(Msg{Text: "hello", Blocks: Blocks{BlockSet: []Block{NewDividerBlock()}}})
+	if err != nil {
+		t.Fatalf("Unexpected error: %s", err)
+	}
+	if !strings.Contains(string(encoded), `"blocks":[{"type":"divider"}]`) {
+		t.Errorf("Expected the blocks to be marshalled, got %s", encoded)
+	}
+
+	encoded, err = json.Marshal(Message{
+		Msg:        Msg{Type: "message", SubType: "message_changed"},
+		SubMessage: &Msg{Text: "edited"},
+	})
+	if err != nil {
+		t.Fatalf("Unexpected error: %s", err)
+	}
+	if strings.Contains(string(encoded), "blocks") || !strings.Contains(string(encoded), `"message":{"text":"edited"`) {
+		t.Errorf("Unexpected message encoding: %s", encoded)
+	}
+
+	var decoded Message
+	if err = json.Unmarshal(encoded, &decoded); err != nil {
+		t.Fatalf("Unexpected error: %s", err)
+	}
+	if decoded.SubType != "message_changed" || decoded.SubMessage == nil || decoded.SubMessage.Text != "edited" {
+		t.Errorf("Unexpected round trip: %#v", decoded)
+	}
+}
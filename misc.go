@@ -24,6 +24,13 @@ import (
 type SlackResponse struct {
 	Ok    bool   `json:"ok"`
 	Error string `json:"error"`
+
+	// requestID is the x-slack-req-id of the response, set once decoded.
+	requestID string
+}
+
+func (t *SlackResponse) setRequestID(id string) {
+	t.requestID = id
 }
 
 func (t SlackResponse) Err() error {
@@ -38,24 +45,62 @@ func (t SlackResponse) Err() error {
 		return nil
 	}
 
-	return errors.New(t.Error)
+	return SlackErrorResponse{Err: t.Error, RequestID: t.requestID}
+}
+
+// SlackErrorResponse is the error returned when slack responds with ok false, Err is the error
+// code, e.g. message_not_found.
+type SlackErrorResponse struct {
+	Err string
+	// RequestID is the x-slack-req-id of the response, if any.
+	RequestID string
+}
+
+func (r SlackErrorResponse) Error() string { return r.Err }
+
+// SlackRequestID returns the x-slack-req-id of the response, if any.
+func (r SlackErrorResponse) SlackRequestID() string {
+	return r.RequestID
+}
+
+// isSlackError reports whether err is the slack error with the code.
+func isSlackError(err error, code string) bool {
+	slackErr, ok := err.(SlackErrorResponse)
+	return ok && slackErr.Err == code
 }
 
 // StatusCodeError represents an http response error.
 // type httpStatusCode interface { HTTPStatusCode() int } to handle it.
 type statusCodeError struct {
-	Code   int
-	Status string
+	Code        int
+	Status      string
+	RequestID   string
+	ContentType string
+	// Body holds the beginning of the response body, see OptionErrorBodyLimit.
+	Body string
 }
 
 func (t statusCodeError) Error() string {
-	return fmt.Sprintf("slack server error: %s", t.Status)
+	if t.Body == "" {
+		return fmt.Sprintf("slack server error: %s", t.Status)
+	}
+	return fmt.Sprintf("slack server error: %s (%s): %s", t.Status, t.ContentType, t.Body)
 }
 
 func (t statusCodeError) HTTPStatusCode() int {
 	return t.Code
 }
 
+// SlackRequestID returns the x-slack-req-id of the response, if any.
+func (t statusCodeError) SlackRequestID() string {
+	return t.RequestID
+}
+
+// ResponseBody returns the beginning of the response body, if it was captured.
+func (t statusCodeError) ResponseBody() string {
+	return t.Body
+}
+
 func (t statusCodeError) Retryable() bool {
 	if t.Code >= 500 || t.Code == http.StatusTooManyRequests {
 		return true
@@ -66,6 +111,8 @@ func (t statusCodeError) Retryable() bool {
 // RateLimitedError represents the rate limit respond from slack
 type RateLimitedError struct {
 	RetryAfter time.Duration
+	// RequestID is the x-slack-req-id of the response, if any.
+	RequestID string
 }
 
 func (e *RateLimitedError) Error() string {
@@ -148,13 +195,13 @@ func parseResponseBody(body io.ReadCloser, intf interface{}, d debug) error {
 	}
 
 	if d.Debug() {
-		d.Debugln("parseResponseBody", string(response))
+		d.Debugln("parseResponseBody", redactForDebug(d, "application/json", response))
 	}
 
 	return json.Unmarshal(response, intf)
 }
 
-func postLocalWithMultipartResponse(ctx context.Context, client httpClient, method, fpath, fieldname string, values url.Values, intf interface{}, d debug) error {
+func postLocalWithMultipartResponse(ctx context.Context, client httpClient, method, fpath, fieldname string, values url.Values, intf interface{}, d debug, opts MultipartOptions) error {
 	fullpath, err := filepath.Abs(fpath)
 	if err != nil {
 		return err
@@ -165,16 +212,22 @@ func postLocalWithMultipartResponse(ctx context.Context, client httpClient, meth
 	}
 	defer file.Close()
 
-	return postWithMultipartResponse(ctx, client, method, filepath.Base(fpath), fieldname, values, file, intf, d)
+	return postWithMultipartResponse(ctx, client, method, filepath.Base(fpath), fieldname, values, file, intf, d, opts)
 }
 
-func postWithMultipartResponse(ctx context.Context, client httpClient, path, name, fieldname string, values url.Values, r io.Reader, intf interface{}, d debug) error {
+func postWithMultipartResponse(ctx context.Context, client httpClient, path, name, fieldname string, values url.Values, r io.Reader, intf interface{}, d debug, opts MultipartOptions) error {
 	pipeReader, pipeWriter := io.Pipe()
 	wr := multipart.NewWriter(pipeWriter)
-	errc := make(chan error)
+	errc := make(chan error, 1)
 	go func() {
 		defer pipeWriter.Close()
-		ioWriter, err := wr.CreateFormFile(fieldname, name)
+		if opts.Fields == MultipartFieldsBeforeFile {
+			if err := opts.writeFields(wr, values); err != nil {
+				errc <- err
+				return
+			}
+		}
+		ioWriter, err := opts.createFile(wr, fieldname, name)
 		if err != nil {
 			errc <- err
 			return
@@ -184,12 +237,22 @@ func postWithMultipartResponse(ctx context.Context, client httpClient, path, nam
 			errc <- err
 			return
 		}
+		if opts.Fields == MultipartFieldsAfterFile {
+			if err := opts.writeFields(wr, values); err != nil {
+				errc <- err
+				return
+			}
+		}
 		if err = wr.Close(); err != nil {
 			errc <- err
 			return
 		}
 	}()
-	req, err := fileUploadReq(ctx, path, values, pipeReader)
+	query := values
+	if opts.Fields != MultipartFieldsInQuery {
+		query = url.Values{}
+	}
+	req, err := fileUploadReq(ctx, path, query, pipeReader)
 	if err != nil {
 		return err
 	}
@@ -238,7 +301,7 @@ func postJSON(ctx context.Context, client httpClient, endpoint, token string, js
 	if err != nil {
 		return err
 	}
-	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set("Content-Type", "application/json; charset=utf-8")
 	req.Header.Set("Authorization", fmt.Sprintf("Bearer %s", token))
 
 	return doPost(ctx, client, req, newJSONParser(intf), d)
@@ -266,19 +329,36 @@ func getResource(ctx context.Context, client httpClient, endpoint string, values
 	return doPost(ctx, client, req, newJSONParser(intf), d)
 }
 
-func parseAdminResponse(ctx context.Context, client httpClient, method string, teamName string, values url.Values, intf interface{}, d debug) error {
-	endpoint := fmt.Sprintf(WEBAPIURLFormat, teamName, method, time.Now().Unix())
+func parseAdminResponse(ctx context.Context, client httpClient, urlFormat string, method string, teamName string, values url.Values, intf interface{}, d debug) error {
+	endpoint := fmt.Sprintf(urlFormat, teamName, method, time.Now().Unix())
 	return postForm(ctx, client, endpoint, values, intf, d)
 }
 
 func logResponse(resp *http.Response, d debug) error {
-	if d.Debug() {
+	if !d.Debug() {
+		return nil
+	}
+
+	if debugRedactorOf(d) == nil {
 		text, err := httputil.DumpResponse(resp, true)
 		if err != nil {
 			return err
 		}
 		d.Debugln(string(text))
+		return nil
+	}
+
+	text, err := httputil.DumpResponse(resp, false)
+	if err != nil {
+		return err
+	}
+	body, err := ioutil.ReadAll(resp.Body)
+	resp.Body.Close()
+	resp.Body = ioutil.NopCloser(bytes.NewReader(body))
+	if err != nil {
+		return err
 	}
+	d.Debugln(string(text) + redactForDebug(d, resp.Header.Get("Content-Type"), body))
 
 	return nil
 }
@@ -299,29 +379,103 @@ func timerReset(t *time.Timer, d time.Duration) {
 	t.Reset(d)
 }
 
+// responseObserver is implemented by the debug values that want to inspect every response, e.g. the Client.
+type responseObserver interface {
+	observeResponse(resp *http.Response)
+}
+
+// redactingDebug is implemented by the debug values that redact the payloads they log, e.g. a Client configured with OptionRedactor.
+type redactingDebug interface {
+	debugRedactor() *Redactor
+}
+
+func debugRedactorOf(d debug) *Redactor {
+	if r, ok := d.(redactingDebug); ok {
+		return r.debugRedactor()
+	}
+	return nil
+}
+
+// redactForDebug redacts body when d has a redactor.
+func redactForDebug(d debug, contentType string, body []byte) string {
+	if r := debugRedactorOf(d); r != nil {
+		return r.Redact(contentType, body)
+	}
+	return string(body)
+}
+
+// errorBodyLimiter is implemented by the debug values that configure how much of an error body is captured, e.g. the Client.
+type errorBodyLimiter interface {
+	maxErrorBody() int
+}
+
 func checkStatusCode(resp *http.Response, d debug) error {
+	if o, ok := d.(responseObserver); ok {
+		o.observeResponse(resp)
+	}
+
 	if resp.StatusCode == http.StatusTooManyRequests {
 		retry, err := strconv.ParseInt(resp.Header.Get("Retry-After"), 10, 64)
 		if err != nil {
 			return err
 		}
-		return &RateLimitedError{time.Duration(retry) * time.Second}
+		return &RateLimitedError{RetryAfter: time.Duration(retry) * time.Second, RequestID: resp.Header.Get(hRequestID)}
 	}
 
 	// Slack seems to send an HTML body along with 5xx error codes. Don't parse it.
 	if resp.StatusCode != http.StatusOK {
 		logResponse(resp, d)
-		return statusCodeError{Code: resp.StatusCode, Status: resp.Status}
+		limit := DefaultErrorBodyLimit
+		if l, ok := d.(errorBodyLimiter); ok {
+			limit = l.maxErrorBody()
+		}
+		contentType := resp.Header.Get("Content-Type")
+		body := readErrorBody(resp.Body, limit)
+		if r := debugRedactorOf(d); r != nil {
+			body = r.Redact(contentType, []byte(body))
+		}
+		return statusCodeError{
+			Code:        resp.StatusCode,
+			Status:      resp.Status,
+			RequestID:   resp.Header.Get(hRequestID),
+			ContentType: contentType,
+			Body:        body,
+		}
 	}
 
 	return nil
 }
 
+// readErrorBody reads up to limit bytes of body, marking the result when it was truncated.
+func readErrorBody(body io.Reader, limit int) string {
+	if limit <= 0 {
+		return ""
+	}
+
+	b, _ := ioutil.ReadAll(io.LimitReader(body, int64(limit)+1))
+	if len(b) > limit {
+		return strings.TrimSpace(string(b[:limit])) + "..."
+	}
+	return strings.TrimSpace(string(b))
+}
+
 type responseParser func(*http.Response) error
 
+// requestIDSetter is implemented by the responses embedding SlackResponse.
+type requestIDSetter interface {
+	setRequestID(id string)
+}
+
 func newJSONParser(dst interface{}) responseParser {
 	return func(resp *http.Response) error {
-		return json.NewDecoder(resp.Body).Decode(dst)
+		if err := json.NewDecoder(resp.Body).Decode(dst); err != nil {
+			return err
+		}
+		// the request id is kept so that ok:false errors carry it, see SlackResponse.Err.
+		if s, ok := dst.(requestIDSetter); ok {
+			s.setRequestID(resp.Header.Get(hRequestID))
+		}
+		return nil
 	}
 }
 
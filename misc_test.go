@@ -89,6 +89,17 @@ func TestParseResponseInvalidToken(t *testing.T) {
 	}
 }
 
+func TestSlackResponseErr(t *testing.T) {
+	err := SlackResponse{Ok: false, Error: "message_not_found"}.Err()
+
+	if slackErr, ok := err.(SlackErrorResponse); !ok || slackErr.Err != "message_not_found" || err.Error() != "message_not_found" {
+		t.Errorf("expected a SlackErrorResponse, got %#v", err)
+	}
+	if !isSlackError(err, "message_not_found") || isSlackError(err, "channel_not_found") || isSlackError(nil, "message_not_found") {
+		t.Error("unexpected isSlackError result")
+	}
+}
+
 func TestRetryable(t *testing.T) {
 	for _, e := range []error{
 		&RateLimitedError{},
@@ -104,3 +115,92 @@ func TestRetryable(t *testing.T) {
 		}
 	}
 }
+
+func TestResponseHook(t *testing.T) {
+	http.HandleFunc("/requestid.ok", func(rw http.ResponseWriter, r *http.Request) {
+		rw.Header().Set("Content-Type", "application/json")
+		rw.Header().Set("X-Slack-Req-Id", "req-ok")
+		rw.Write([]byte(`{"ok": true}`))
+	})
+	http.HandleFunc("/requestid.fail", func(rw http.ResponseWriter, r *http.Request) {
+		rw.Header().Set("X-Slack-Req-Id", "req-fail")
+		rw.WriteHeader(http.StatusInternalServerError)
+	})
+
+	once.Do(startServer)
+	var responses []ResponseInfo
+	api := New("testing-token", OptionAPIURL("http://"+serverAddr+"/"), OptionResponseHook(func(info ResponseInfo) {
+		responses = append(responses, info)
+	}))
+
+	if err := api.postMethod(context.Background(), "requestid.ok", url.Values{}, &SlackResponse{}); err != nil {
+		t.Fatalf("Unexpected error: %s", err)
+	}
+
+	err := api.postMethod(context.Background(), "requestid.fail", url.Values{}, &SlackResponse{})
+	if e, ok := err.(interface{ SlackRequestID() string }); !ok || e.SlackRequestID() != "req-fail" {
+		t.Fatalf("Expected error carrying the request id, got %#v", err)
+	}
+
+	if len(responses) != 2 {
+		t.Fatalf("Expected 2 responses, got %d", len(responses))
+	}
+	if responses[0].Method != "requestid.ok" || responses[0].RequestID != "req-ok" || responses[0].StatusCode != http.StatusOK {
+		t.Errorf("Unexpected response info: %#v", responses[0])
+	}
+	if responses[1].Method != "requestid.fail" || responses[1].RequestID != "req-fail" || responses[1].StatusCode != http.StatusInternalServerError {
+		t.Errorf("Unexpected response info: %#v", responses[1])
+	}
+}
+
+func TestSlackErrorRequestID(t *testing.T) {
+	http.HandleFunc("/requestid.error", func(rw http.ResponseWriter, r *http.Request) {
+		rw.Header().Set("Content-Type", "application/json")
+		rw.Header().Set("X-Slack-Req-Id", "req-error")
+		rw.Write([]byte(`{"ok": false, "error": "channel_not_found"}`))
+	})
+
+	once.Do(startServer)
+	api := New("testing-token", OptionAPIURL("http://"+serverAddr+"/"))
+
+	response := struct {
+		SlackResponse
+		Channel string `json:"channel"`
+	}{}
+	if err := api.postMethod(context.Background(), "requestid.error", url.Values{}, &response); err != nil {
+		t.Fatalf("Unexpected error: %s", err)
+	}
+	err := response.Err()
+	if e, ok := err.(SlackErrorResponse); !ok || e.Err != "channel_not_found" || e.SlackRequestID() != "req-error" {
+		t.Fatalf("Expected error carrying the request id, got %#v", err)
+	}
+}
+
+func TestStatusCodeErrorBody(t *testing.T) {
+	http.HandleFunc("/errorbody.html", func(rw http.ResponseWriter, r *http.Request) {
+		rw.Header().Set("Content-Type", "text/html")
+		rw.WriteHeader(http.StatusBadGateway)
+		rw.Write([]byte("<html><body>upstream connect error</body></html>"))
+	})
+
+	once.Do(startServer)
+	api := New("testing-token", OptionAPIURL("http://"+serverAddr+"/"), OptionErrorBodyLimit(12))
+
+	err := api.postMethod(context.Background(), "errorbody.html", url.Values{}, &SlackResponse{})
+	e, ok := err.(statusCodeError)
+	if !ok {
+		t.Fatalf("expected a statusCodeError, got %#v", err)
+	}
+	if e.ContentType != "text/html" || e.ResponseBody() != "<html><body>..." {
+		t.Errorf("unexpected captured body: %q %q", e.ContentType, e.ResponseBody())
+	}
+	if want := "slack server error: 502 Bad Gateway (text/html): <html><body>..."; err.Error() != want {
+		t.Errorf("expected %q, got %q", want, err.Error())
+	}
+
+	api = New("testing-token", OptionAPIURL("http://"+serverAddr+"/"), OptionErrorBodyLimit(0))
+	err = api.postMethod(context.Background(), "errorbody.html", url.Values{}, &SlackResponse{})
+	if want := "slack server error: 502 Bad Gateway"; err == nil || err.Error() != want {
+		t.Errorf("expected %q, got %v", want, err)
+	}
+}
@@ -0,0 +1,114 @@
+package slack
+
+import (
+	"fmt"
+	"io"
+	"mime/multipart"
+	"net/textproto"
+	"net/url"
+	"sort"
+	"strings"
+)
+
+// MultipartFields is where the fields of a file upload are sent.
+type MultipartFields int
+
+const (
+	// MultipartFieldsInQuery sends the fields in the query string, the file being the only part.
+	MultipartFieldsInQuery MultipartFields = iota
+	// MultipartFieldsBeforeFile sends the fields as parts preceding the file, as expected by
+	// proxies rejecting bodies where the file comes first.
+	MultipartFieldsBeforeFile
+	// MultipartFieldsAfterFile sends the fields as parts following the file.
+	MultipartFieldsAfterFile
+)
+
+// FilenameEncoding is how the filename of an uploaded file is written in its part header.
+type FilenameEncoding int
+
+const (
+	// FilenameEncodingRaw writes the filename as is, quoted.
+	FilenameEncodingRaw FilenameEncoding = iota
+	// FilenameEncodingASCII replaces the characters of the filename which aren't printable ASCII with underscores.
+	FilenameEncodingASCII
+	// FilenameEncodingRFC5987 writes an ASCII filename along with the UTF-8 filename* parameter of RFC 5987.
+	FilenameEncodingRFC5987
+)
+
+// MultipartOptions controls the multipart body of file uploads, to interoperate with middleboxes
+// strict about its layout. The zero value matches the default behaviour.
+type MultipartOptions struct {
+	Fields           MultipartFields
+	FilenameEncoding FilenameEncoding
+	// Charset is declared in the Content-Type of the field parts, e.g. "utf-8", when set.
+	Charset string
+}
+
+var quoteEscaper = strings.NewReplacer("\\", "\\\\", `"`, "\\\"")
+
+// writeFields writes the values as parts, sorted by name.
+func (o MultipartOptions) writeFields(wr *multipart.Writer, values url.Values) error {
+	names := make([]string, 0, len(values))
+	for name := range values {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	for _, name := range names {
+		for _, value := range values[name] {
+			header := textproto.MIMEHeader{}
+			header.Set("Content-Disposition", fmt.Sprintf(`form-data; name="%s"`, quoteEscaper.Replace(name)))
+			if o.Charset != "" {
+				header.Set("Content-Type", "text/plain; charset="+o.Charset)
+			}
+			w, err := wr.CreatePart(header)
+			if err != nil {
+				return err
+			}
+			if _, err := io.WriteString(w, value); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+// createFile creates the part of the file, named according to the filename encoding.
+func (o MultipartOptions) createFile(wr *multipart.Writer, fieldname, filename string) (io.Writer, error) {
+	disposition := fmt.Sprintf(`form-data; name="%s"; filename="%s"`, quoteEscaper.Replace(fieldname), quoteEscaper.Replace(o.encodeFilename(filename)))
+	if o.FilenameEncoding == FilenameEncodingRFC5987 {
+		disposition += "; filename*=UTF-8''" + rfc5987Escape(filename)
+	}
+
+	header := textproto.MIMEHeader{}
+	header.Set("Content-Disposition", disposition)
+	header.Set("Content-Type", "application/octet-stream")
+	return wr.CreatePart(header)
+}
+
+func (o MultipartOptions) encodeFilename(filename string) string {
+	if o.FilenameEncoding == FilenameEncodingRaw {
+		return filename
+	}
+
+	return strings.Map(func(r rune) rune {
+		if r < 0x20 || r > 0x7e {
+			return '_'
+		}
+		return r
+	}, filename)
+}
+
+// rfc5987Escape percent-encodes the characters of s which aren't attr-char, as defined by RFC 5987.
+func rfc5987Escape(s string) string {
+	var b strings.Builder
+	for i := 0; i < len(s); i++ {
+		c := s[i]
+		if ('a' <= c && c <= 'z') || ('A' <= c && c <= 'Z') || ('0' <= c && c <= '9') || strings.IndexByte("!#$&+-.^_`|~", c) >= 0 {
+			b.WriteByte(c)
+			continue
+		}
+		fmt.Fprintf(&b, "%%%02X", c)
+	}
+	return b.String()
+}
@@ -0,0 +1,103 @@
+package slack
+
+import (
+	"bytes"
+	"io/ioutil"
+	"net/http"
+	"reflect"
+	"testing"
+)
+
+// uploadedPart is a part of a multipart body received during the test.
+type uploadedPart struct {
+	disposition string
+	contentType string
+	body        string
+}
+
+func TestUploadFileMultipartOptions(t *testing.T) {
+	http.DefaultServeMux = new(http.ServeMux)
+	defer func() { http.DefaultServeMux = new(http.ServeMux) }()
+
+	var (
+		query string
+		parts []uploadedPart
+	)
+	http.HandleFunc("/auth.test", authTestHandler)
+	http.HandleFunc("/files.upload", func(rw http.ResponseWriter, r *http.Request) {
+		query = r.URL.RawQuery
+		parts = nil
+		reader, err := r.MultipartReader()
+		if err != nil {
+			t.Errorf("unexpected error: %s", err)
+			return
+		}
+		for {
+			part, err := reader.NextPart()
+			if err != nil {
+				break
+			}
+			body, _ := ioutil.ReadAll(part)
+			parts = append(parts, uploadedPart{
+				disposition: part.Header.Get("Content-Disposition"),
+				contentType: part.Header.Get("Content-Type"),
+				body:        string(body),
+			})
+		}
+		uploadFileHandler(rw, r)
+	})
+	once.Do(startServer)
+	api := New("testing-token", OptionAPIURL("http://"+serverAddr+"/"))
+
+	tests := []struct {
+		options MultipartOptions
+		query   string
+		parts   []uploadedPart
+	}{
+		{
+			options: MultipartOptions{},
+			query:   "channels=C1&filename=r%C3%A9sum%C3%A9.txt&filetype=text&token=testing-token",
+			parts: []uploadedPart{
+				{`form-data; name="file"; filename="résumé.txt"`, "application/octet-stream", "content"},
+			},
+		},
+		{
+			options: MultipartOptions{Fields: MultipartFieldsBeforeFile, FilenameEncoding: FilenameEncodingRFC5987, Charset: "utf-8"},
+			parts: []uploadedPart{
+				{`form-data; name="channels"`, "text/plain; charset=utf-8", "C1"},
+				{`form-data; name="filename"`, "text/plain; charset=utf-8", "résumé.txt"},
+				{`form-data; name="filetype"`, "text/plain; charset=utf-8", "text"},
+				{`form-data; name="token"`, "text/plain; charset=utf-8", "testing-token"},
+				{`form-data; name="file"; filename="r_sum_.txt"; filename*=UTF-8''r%C3%A9sum%C3%A9.txt`, "application/octet-stream", "content"},
+			},
+		},
+		{
+			options: MultipartOptions{Fields: MultipartFieldsAfterFile, FilenameEncoding: FilenameEncodingASCII},
+			parts: []uploadedPart{
+				{`form-data; name="file"; filename="r_sum_.txt"`, "application/octet-stream", "content"},
+				{`form-data; name="channels"`, "", "C1"},
+				{`form-data; name="filename"`, "", "résumé.txt"},
+				{`form-data; name="filetype"`, "", "text"},
+				{`form-data; name="token"`, "", "testing-token"},
+			},
+		},
+	}
+
+	for i, test := range tests {
+		params := FileUploadParameters{
+			Filename:  "résumé.txt",
+			Reader:    bytes.NewBufferString("content"),
+			Channels:  []string{"C1"},
+			Multipart: test.options,
+		}
+		if _, err := api.UploadFile(params); err != nil {
+			t.Fatalf("%d: unexpected error: %s", i, err)
+		}
+		if query != test.query {
+			t.Errorf("%d: expected query %q, got %q", i, test.query, query)
+		}
+		if !reflect.DeepEqual(parts, test.parts) {
+			t.Errorf("%d: expected parts %#v, got %#v", i, test.parts, parts)
+		}
+	}
+}
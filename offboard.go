@@ -0,0 +1,196 @@
+package slack
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+	"strconv"
+	"strings"
+)
+
+// OffboardParameters controls the offboarding of a user.
+type OffboardParameters struct {
+	// TeamName is the subdomain of the workspace, the account is deactivated when set.
+	// Deactivation uses the undocumented users.admin API and requires an admin token.
+	TeamName string
+	// KeepUserGroups leaves the user in their user groups.
+	KeepUserGroups bool
+	// UserClient is a client authenticated as the user, e.g. with a token obtained through
+	// OAuth. When set, the messages scheduled by the user are scheduled again by the client,
+	// mentioning the user, before being deleted. Reminders can't be listed for other users and
+	// are left as they are.
+	UserClient *Client
+	// SummaryChannel is where a summary of the offboarding is posted, when set.
+	SummaryChannel string
+}
+
+// OffboardReport is the outcome of the offboarding of a user, steps which failed are
+// recorded in Errors and don't prevent the following steps from running.
+type OffboardReport struct {
+	User        string
+	Deactivated bool
+	// UserGroups are the handles of the user groups the user was removed from.
+	UserGroups []string
+	// SoleMemberUserGroups are the handles of the user groups the user was the only member of.
+	// They're left as they are since a user group can't be emptied, they must be disabled or
+	// given other members.
+	SoleMemberUserGroups []string
+	// ScheduledMessages is the number of scheduled messages taken over from the user.
+	ScheduledMessages int
+	Errors            []error
+}
+
+// Offboard removes a user from the workspace: it takes over the messages they scheduled, deactivates
+// the account, removes the user from their user groups and posts a summary. The client must
+// use a token allowed to manage user groups, and to schedule messages in the channels concerned.
+func (api *Client) Offboard(userID string, params OffboardParameters) OffboardReport {
+	return api.OffboardContext(context.Background(), userID, params)
+}
+
+// OffboardContext removes a user from the workspace with a custom context.
+func (api *Client) OffboardContext(ctx context.Context, userID string, params OffboardParameters) OffboardReport {
+	report := OffboardReport{User: userID}
+	fail := func(step string, err error) {
+		report.Errors = append(report.Errors, fmt.Errorf("%s: %s", step, err))
+	}
+
+	// the scheduled messages are taken over first, deactivation revokes the tokens of the user.
+	if params.UserClient != nil {
+		n, err := api.takeOverScheduledMessages(ctx, params.UserClient, userID)
+		report.ScheduledMessages = n
+		if err != nil {
+			fail("taking over the scheduled messages", err)
+		}
+	}
+
+	if params.TeamName != "" {
+		if err := api.DisableUserContext(ctx, params.TeamName, userID); err != nil {
+			fail("deactivating the user", err)
+		} else {
+			report.Deactivated = true
+		}
+	}
+
+	if !params.KeepUserGroups {
+		groups, err := api.GetUserGroupsContext(ctx, GetUserGroupsOptionIncludeUsers(true))
+		if err != nil {
+			fail("listing the user groups", err)
+		}
+		for _, group := range groups {
+			members := withoutUser(group.Users, userID)
+			switch {
+			case len(members) == len(group.Users):
+				continue
+			case len(members) == 0:
+				report.SoleMemberUserGroups = append(report.SoleMemberUserGroups, group.Handle)
+				continue
+			}
+			if _, err := api.UpdateUserGroupMembersContext(ctx, group.ID, strings.Join(members, ",")); err != nil {
+				fail("removing the user from "+group.Handle, err)
+				continue
+			}
+			report.UserGroups = append(report.UserGroups, group.Handle)
+		}
+	}
+
+	if params.SummaryChannel != "" {
+		if _, _, err := api.PostMessageContext(ctx, params.SummaryChannel, MsgOptionText(report.Summary(), false)); err != nil {
+			fail("posting the summary", err)
+		}
+	}
+
+	return report
+}
+
+// Summary describes the report as mrkdwn.
+func (r OffboardReport) Summary() string {
+	lines := []string{fmt.Sprintf("Offboarded <@%s>:", r.User)}
+	if r.Deactivated {
+		lines = append(lines, "• deactivated the account")
+	}
+	// the handles are written as plain text, mentions would notify the members of the groups.
+	if len(r.UserGroups) > 0 {
+		lines = append(lines, "• removed from "+strings.Join(r.UserGroups, ", "))
+	}
+	if len(r.SoleMemberUserGroups) > 0 {
+		lines = append(lines, "• left in "+strings.Join(r.SoleMemberUserGroups, ", ")+", the user is their only member")
+	}
+	if r.ScheduledMessages > 0 {
+		lines = append(lines, fmt.Sprintf("• took over %d scheduled messages", r.ScheduledMessages))
+	}
+	for _, err := range r.Errors {
+		lines = append(lines, "• failed "+err.Error())
+	}
+	return strings.Join(lines, "\n")
+}
+
+// scheduledMessage is an entry of chat.scheduledMessages.list.
+type scheduledMessage struct {
+	ID          string       `json:"id"`
+	ChannelID   string       `json:"channel_id"`
+	PostAt      int64        `json:"post_at"`
+	Text        string       `json:"text"`
+	Blocks      Blocks       `json:"blocks"`
+	Attachments []Attachment `json:"attachments"`
+}
+
+// takeOverScheduledMessages schedules the messages of the user client with api before deleting them,
+// returning the number of messages taken over.
+func (api *Client) takeOverScheduledMessages(ctx context.Context, user *Client, userID string) (int, error) {
+	n := 0
+	cursor := ""
+	for {
+		values := url.Values{"token": {user.token}}
+		if cursor != "" {
+			values.Set("cursor", cursor)
+		}
+		response := struct {
+			Messages         []scheduledMessage `json:"scheduled_messages"`
+			ResponseMetaData responseMetaData   `json:"response_metadata"`
+			SlackResponse
+		}{}
+		if err := user.postMethod(ctx, "chat.scheduledMessages.list", values, &response); err != nil {
+			return n, err
+		}
+		if err := response.Err(); err != nil {
+			return n, err
+		}
+
+		for _, message := range response.Messages {
+			note := fmt.Sprintf("_Scheduled by <@%s>_", userID)
+			blocks := message.Blocks.BlockSet
+			if len(blocks) > 0 {
+				// the text is only a fallback once there are blocks, the note goes with them.
+				blocks = append(blocks[:len(blocks):len(blocks)], NewContextBlock("", NewTextBlockObject(MarkdownType, note, false, false)))
+			}
+			options := []MsgOption{
+				MsgOptionSchedule(strconv.FormatInt(message.PostAt, 10)),
+				MsgOptionText(message.Text+"\n"+note, false),
+				MsgOptionBlocks(blocks...),
+				MsgOptionAttachments(message.Attachments...),
+			}
+			if _, _, _, err := api.SendMessageContext(ctx, message.ChannelID, options...); err != nil {
+				return n, err
+			}
+			if _, err := user.DeleteScheduledMessageContext(ctx, &DeleteScheduledMessageParameters{Channel: message.ChannelID, ScheduledMessageID: message.ID}); err != nil {
+				return n, err
+			}
+			n++
+		}
+
+		if response.ResponseMetaData.NextCursor == "" {
+			return n, nil
+		}
+		cursor = response.ResponseMetaData.NextCursor
+	}
+}
+
+func withoutUser(users []string, userID string) []string {
+	remaining := make([]string, 0, len(users))
+	for _, user := range users {
+		if user != userID {
+			remaining = append(remaining, user)
+		}
+	}
+	return remaining
+}
@@ -0,0 +1,116 @@
+package slack
+
+import (
+	"net/http"
+	"reflect"
+	"strings"
+	"sync"
+	"testing"
+)
+
+// localClient sends the requests to the test server whatever their host, e.g. for users.admin methods.
+type localClient struct{}
+
+func (localClient) Do(req *http.Request) (*http.Response, error) {
+	req.URL.Scheme = "http"
+	req.URL.Host = serverAddr
+	return http.DefaultClient.Do(req)
+}
+
+func TestOffboard(t *testing.T) {
+	http.DefaultServeMux = new(http.ServeMux)
+	defer func() { http.DefaultServeMux = new(http.ServeMux) }()
+
+	var (
+		mu        sync.Mutex
+		calls     []string
+		summaries []string
+		scheduled []string
+	)
+	record := func(call string) {
+		mu.Lock()
+		defer mu.Unlock()
+		calls = append(calls, call)
+	}
+	reply := func(rw http.ResponseWriter, body string) {
+		rw.Header().Set("Content-Type", "application/json")
+		rw.Write([]byte(body))
+	}
+
+	http.HandleFunc("/api/users.admin.setInactive", func(rw http.ResponseWriter, r *http.Request) {
+		record("setInactive " + r.FormValue("user"))
+		reply(rw, `{"ok": true}`)
+	})
+	http.HandleFunc("/usergroups.list", func(rw http.ResponseWriter, r *http.Request) {
+		reply(rw, `{"ok": true, "usergroups": [
+			{"id": "S1", "handle": "oncall", "users": ["U1", "U2"]},
+			{"id": "S2", "handle": "design", "users": ["U3"]},
+			{"id": "S3", "handle": "solo", "users": ["U1"]}
+		]}`)
+	})
+	http.HandleFunc("/usergroups.users.update", func(rw http.ResponseWriter, r *http.Request) {
+		record("update " + r.FormValue("usergroup") + " " + r.FormValue("users"))
+		reply(rw, `{"ok": true, "usergroup": {"id": "`+r.FormValue("usergroup")+`"}}`)
+	})
+	http.HandleFunc("/chat.scheduledMessages.list", func(rw http.ResponseWriter, r *http.Request) {
+		record("list " + r.FormValue("token"))
+		reply(rw, `{"ok": true, "scheduled_messages": [{"id": "Q1", "channel_id": "C1", "post_at": 1700000000, "text": "standup",
+			"blocks": [{"type": "section", "text": {"type": "mrkdwn", "text": "*standup*"}}],
+			"attachments": [{"fallback": "notes", "text": "notes"}]}]}`)
+	})
+	http.HandleFunc("/chat.scheduleMessage", func(rw http.ResponseWriter, r *http.Request) {
+		record("schedule " + r.FormValue("token") + " " + r.FormValue("channel") + " " + r.FormValue("post_at"))
+		mu.Lock()
+		scheduled = append(scheduled, r.FormValue("blocks"), r.FormValue("attachments"))
+		mu.Unlock()
+		reply(rw, `{"ok": true, "channel": "C1", "scheduled_message_id": "Q2", "post_at": "1700000000"}`)
+	})
+	http.HandleFunc("/chat.deleteScheduledMessage", func(rw http.ResponseWriter, r *http.Request) {
+		record("delete " + r.FormValue("token") + " " + r.FormValue("scheduled_message_id"))
+		reply(rw, `{"ok": true}`)
+	})
+	http.HandleFunc("/chat.postMessage", func(rw http.ResponseWriter, r *http.Request) {
+		mu.Lock()
+		summaries = append(summaries, r.FormValue("text"))
+		mu.Unlock()
+		reply(rw, `{"ok": true, "channel": "CIT", "ts": "1.0"}`)
+	})
+
+	once.Do(startServer)
+	api := New("admin-token", OptionAPIURL("http://"+serverAddr+"/"), OptionHTTPClient(localClient{}))
+	user := New("user-token", OptionAPIURL("http://"+serverAddr+"/"))
+
+	report := api.Offboard("U1", OffboardParameters{TeamName: "acme", UserClient: user, SummaryChannel: "CIT"})
+
+	// the scheduled messages are taken over while the token of the user is still valid.
+	expected := []string{
+		"list user-token",
+		"schedule admin-token C1 1700000000",
+		"delete user-token Q1",
+		"setInactive U1",
+		"update S1 U2",
+	}
+	if !reflect.DeepEqual(calls, expected) {
+		t.Errorf("Unexpected calls:\n%s", strings.Join(calls, "\n"))
+	}
+
+	expectedScheduled := []string{
+		`[{"type":"section","text":{"type":"mrkdwn","text":"*standup*"}},{"type":"context","elements":[{"type":"mrkdwn","text":"_Scheduled by \u003c@U1\u003e_"}]}]`,
+		`[{"fallback":"notes","text":"notes","blocks":null}]`,
+	}
+	if !reflect.DeepEqual(scheduled, expectedScheduled) {
+		t.Errorf("Unexpected scheduled message:\n%s", strings.Join(scheduled, "\n"))
+	}
+
+	if !report.Deactivated || !reflect.DeepEqual(report.UserGroups, []string{"oncall"}) || !reflect.DeepEqual(report.SoleMemberUserGroups, []string{"solo"}) || report.ScheduledMessages != 1 {
+		t.Errorf("Unexpected report: %#v", report)
+	}
+	if len(report.Errors) != 0 {
+		t.Errorf("Unexpected errors: %v", report.Errors)
+	}
+
+	summary := "Offboarded <@U1>:\n• deactivated the account\n• removed from oncall\n• left in solo, the user is their only member\n• took over 1 scheduled messages"
+	if len(summaries) != 1 || summaries[0] != summary {
+		t.Errorf("Unexpected summary: %q", summaries)
+	}
+}
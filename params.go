@@ -0,0 +1,77 @@
+package slack
+
+import (
+	"context"
+	"encoding/json"
+	"net/url"
+)
+
+type paramsContextKey struct{}
+
+// ContextWithParams returns a context adding the parameters to the API calls made with it,
+// e.g. to send a field slack just shipped before this library supports it:
+//
+//	ctx = slack.ContextWithParams(ctx, url.Values{"new_field": {"true"}})
+//	api.GetConversationInfoContext(ctx, "C123", false)
+//
+// The parameters replace those with the same name set by the library. They are added to the query
+// of GET requests, to the form of POST requests and as top level fields of JSON bodies, where a
+// parameter with multiple values is sent as an array of strings.
+// Parameters added by an enclosing context are kept unless replaced.
+func ContextWithParams(ctx context.Context, params url.Values) context.Context {
+	merged := url.Values{}
+	mergeParams(merged, contextParams(ctx))
+	mergeParams(merged, params)
+	return context.WithValue(ctx, paramsContextKey{}, merged)
+}
+
+func contextParams(ctx context.Context) url.Values {
+	params, _ := ctx.Value(paramsContextKey{}).(url.Values)
+	return params
+}
+
+// mergeParams sets the parameters in values, replacing the existing ones.
+func mergeParams(values, params url.Values) {
+	for key, v := range params {
+		values[key] = append([]string(nil), v...)
+	}
+}
+
+// mergeJSONParams sets the parameters of the context as top level fields of the JSON object.
+func mergeJSONParams(ctx context.Context, body []byte) ([]byte, error) {
+	params := contextParams(ctx)
+	if len(params) == 0 {
+		return body, nil
+	}
+
+	fields := map[string]json.RawMessage{}
+	if err := json.Unmarshal(body, &fields); err != nil {
+		return nil, err
+	}
+
+	for key, v := range params {
+		var (
+			encoded []byte
+			err     error
+		)
+		if len(v) == 1 {
+			encoded, err = json.Marshal(v[0])
+		} else {
+			encoded, err = json.Marshal(v)
+		}
+		if err != nil {
+			return nil, err
+		}
+		fields[key] = encoded
+	}
+
+	return json.Marshal(fields)
+}
+
+// msgOptionContextParams adds the parameters of the context to the message, it must be the last option applied.
+func msgOptionContextParams(params url.Values) MsgOption {
+	return func(config *sendConfig) error {
+		mergeParams(config.values, params)
+		return nil
+	}
+}
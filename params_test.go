@@ -0,0 +1,63 @@
+package slack
+
+import (
+	"context"
+	"net/http"
+	"net/url"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestContextWithParams(t *testing.T) {
+	http.DefaultServeMux = new(http.ServeMux)
+	defer func() { http.DefaultServeMux = new(http.ServeMux) }()
+
+	var forms []url.Values
+	record := func(rw http.ResponseWriter, r *http.Request) {
+		r.ParseForm()
+		forms = append(forms, r.Form)
+		rw.Header().Set("Content-Type", "application/json")
+		rw.Write([]byte(`{"ok": true, "channel": "C123", "ts": "1234567890.000100"}`))
+	}
+	http.HandleFunc("/auth.test", record)
+	http.HandleFunc("/chat.postMessage", record)
+	once.Do(startServer)
+	api := New(validToken, OptionAPIURL("http://"+serverAddr+"/"))
+
+	ctx := ContextWithParams(context.Background(), url.Values{"new_field": {"1"}, "other": {"a"}})
+	ctx = ContextWithParams(ctx, url.Values{"other": {"b", "c"}})
+
+	if _, err := api.AuthTestContext(ctx); err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	if _, _, err := api.PostMessageContext(ctx, "C123", MsgOptionText("hello", false)); err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	if !assert.Len(t, forms, 2) {
+		return
+	}
+	for _, form := range forms {
+		assert.Equal(t, []string{"1"}, form["new_field"])
+		assert.Equal(t, []string{"b", "c"}, form["other"])
+	}
+	assert.Equal(t, "hello", forms[1].Get("text"))
+}
+
+func TestMergeJSONParams(t *testing.T) {
+	body := []byte(`{"trigger_id":"T1","view":{"type":"modal"}}`)
+
+	unchanged, err := mergeJSONParams(context.Background(), body)
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	assert.Equal(t, string(body), string(unchanged))
+
+	ctx := ContextWithParams(context.Background(), url.Values{"trigger_id": {"T2"}, "tags": {"a", "b"}})
+	merged, err := mergeJSONParams(ctx, body)
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	assert.JSONEq(t, `{"trigger_id":"T2","view":{"type":"modal"},"tags":["a","b"]}`, string(merged))
+}
@@ -0,0 +1,376 @@
+package slack
+
+import (
+	"fmt"
+	"regexp"
+	"strconv"
+	"strings"
+)
+
+const (
+	ansiReset  = "\x1b[0m"
+	ansiBold   = "\x1b[1m"
+	ansiDim    = "\x1b[2m"
+	ansiItalic = "\x1b[3m"
+	ansiRed    = "\x1b[31m"
+	ansiGreen  = "\x1b[32m"
+)
+
+// DefaultPreviewWidth is the width of the dividers rendered by a PreviewRenderer.
+const DefaultPreviewWidth = 40
+
+var (
+	previewEntityPattern = regexp.MustCompile(`<([^<>|]+)(?:\|([^<>]*))?>`)
+	previewBoldPattern   = regexp.MustCompile(`\*([^*\n]+)\*`)
+	previewItalicPattern = regexp.MustCompile(`\b_([^_\n]+)_\b`)
+)
+
+// PreviewRenderer renders messages as readable text, e.g. to preview what a bot will send from
+// a command line tool or in logs. Sections are rendered as paragraphs, buttons as [Label],
+// select menus as [Placeholder ▾] and dividers as lines. Mentions and links are resolved to their
+// labels, the IDs of users and channels aren't looked up.
+type PreviewRenderer struct {
+	// ANSI enables the terminal escape sequences for bold headers and text, dimmed context
+	// and colored buttons.
+	ANSI bool
+	// Width is the width of the dividers, DefaultPreviewWidth when zero.
+	Width int
+}
+
+// RenderMessage renders the blocks and attachments of the message, or its text when it has no blocks.
+func (r PreviewRenderer) RenderMessage(msg Message) string {
+	var parts []string
+	if len(msg.Blocks.BlockSet) > 0 {
+		parts = append(parts, r.RenderBlocks(msg.Blocks.BlockSet...))
+	} else if msg.Text != "" {
+		parts = append(parts, r.mrkdwn(msg.Text))
+	}
+
+	for _, attachment := range msg.Attachments {
+		parts = append(parts, r.renderAttachment(attachment))
+	}
+
+	return strings.Join(parts, "\n")
+}
+
+// RenderBlocks renders the blocks, one after the other.
+func (r PreviewRenderer) RenderBlocks(blocks ...Block) string {
+	lines := make([]string, 0, len(blocks))
+	for _, block := range blocks {
+		if s := r.renderBlock(block); s != "" {
+			lines = append(lines, s)
+		}
+	}
+	return strings.Join(lines, "\n")
+}
+
+func (r PreviewRenderer) renderBlock(block Block) string {
+	switch b := block.(type) {
+	case *SectionBlock:
+		var lines []string
+		if b.Text != nil {
+			lines = append(lines, r.text(b.Text))
+		}
+		for _, field := range b.Fields {
+			lines = append(lines, r.text(field))
+		}
+		if b.Accessory != nil {
+			lines = append(lines, r.renderElement(b.Accessory))
+		}
+		return strings.Join(lines, "\n")
+	case *DividerBlock:
+		width := r.Width
+		if width <= 0 {
+			width = DefaultPreviewWidth
+		}
+		return r.style(ansiDim, strings.Repeat("─", width))
+	case *MarkdownBlock:
+		return b.Text
+	case *HeaderBlock:
+		if b.Text == nil {
+			return ""
+		}
+		return r.style(ansiBold, b.Text.Text)
+	case *ImageBlock:
+		if b.Title != nil {
+			return fmt.Sprintf("%s\n[image: %s]", r.text(b.Title), b.AltText)
+		}
+		return fmt.Sprintf("[image: %s]", b.AltText)
+	case *ContextBlock:
+		parts := make([]string, 0, len(b.ContextElements.Elements))
+		for _, element := range b.ContextElements.Elements {
+			switch e := element.(type) {
+			case *TextBlockObject:
+				parts = append(parts, r.text(e))
+			case *ImageBlockElement:
+				parts = append(parts, fmt.Sprintf("[%s]", e.AltText))
+			}
+		}
+		return r.style(ansiDim, strings.Join(parts, " · "))
+	case *ActionBlock:
+		parts := make([]string, 0, len(b.Elements.ElementSet))
+		for _, element := range b.Elements.ElementSet {
+			parts = append(parts, r.renderElement(element))
+		}
+		return strings.Join(parts, " ")
+	case *InputBlock:
+		label := ""
+		if b.Label != nil {
+			label = r.text(b.Label)
+		}
+		s := label + ": " + r.renderElement(b.Element)
+		if b.Hint != nil {
+			s += "\n" + r.style(ansiDim, r.text(b.Hint))
+		}
+		return s
+	case *FileBlock:
+		return fmt.Sprintf("[file: %s]", b.ExternalID)
+	case *VideoBlock:
+		title := ""
+		if b.Title != nil {
+			title = b.Title.Text
+		}
+		return fmt.Sprintf("[video: %s] %s", title, b.TitleURL)
+	case *CallBlock:
+		if b.Call != nil && b.Call.V1 != nil && b.Call.V1.Name != "" {
+			return fmt.Sprintf("[call: %s]", b.Call.V1.Name)
+		}
+		return "[call]"
+	case *RichTextBlock:
+		return r.renderRichText(b.Elements)
+	default:
+		return r.style(ansiDim, fmt.Sprintf("[%s block]", block.BlockType()))
+	}
+}
+
+func (r PreviewRenderer) renderElement(element BlockElement) string {
+	switch e := element.(type) {
+	case *Accessory:
+		return r.renderElement(toBlockElement(e))
+	case *ButtonBlockElement:
+		label := "[" + r.text(e.Text) + "]"
+		switch e.Style {
+		case StylePrimary:
+			return r.style(ansiGreen, label)
+		case StyleDanger:
+			return r.style(ansiRed, label)
+		}
+		return label
+	case *WorkflowButtonBlockElement:
+		label := "[" + r.text(e.Text) + "]"
+		switch e.Style {
+		case StylePrimary:
+			return r.style(ansiGreen, label)
+		case StyleDanger:
+			return r.style(ansiRed, label)
+		}
+		return label
+	case *SelectBlockElement:
+		return fmt.Sprintf("[%s ▾]", r.textOr(e.Placeholder, "Select"))
+	case *MultiSelectBlockElement:
+		return fmt.Sprintf("[%s ▾]", r.textOr(e.Placeholder, "Select"))
+	case *OverflowBlockElement:
+		return "[…]"
+	case *DatePickerBlockElement:
+		if e.InitialDate != "" {
+			return fmt.Sprintf("[%s]", e.InitialDate)
+		}
+		return fmt.Sprintf("[%s]", r.textOr(e.Placeholder, "Select a date"))
+	case *TimePickerBlockElement:
+		if e.InitialTime != "" {
+			return fmt.Sprintf("[%s]", e.InitialTime)
+		}
+		return fmt.Sprintf("[%s]", r.textOr(e.Placeholder, "Select a time"))
+	case *DateTimePickerBlockElement:
+		if e.InitialDateTime != 0 {
+			return fmt.Sprintf("[%s]", e.InitialTime().UTC().Format("2006-01-02 15:04"))
+		}
+		return "[Select a date and time]"
+	case *PlainTextInputBlockElement:
+		if e.InitialValue != "" {
+			return fmt.Sprintf("[%s]", e.InitialValue)
+		}
+		return fmt.Sprintf("[%s]", r.textOr(e.Placeholder, ""))
+	case *ImageBlockElement:
+		return fmt.Sprintf("[image: %s]", e.AltText)
+	case *CheckboxGroupsBlockElement:
+		parts := make([]string, 0, len(e.Options))
+		for _, option := range e.Options {
+			mark := "[ ]"
+			for _, initial := range e.InitialOptions {
+				if initial.Value == option.Value {
+					mark = "[x]"
+				}
+			}
+			parts = append(parts, mark+" "+r.text(option.Text))
+		}
+		return strings.Join(parts, "  ")
+	case *RadioButtonsBlockElement:
+		parts := make([]string, 0, len(e.Options))
+		for _, option := range e.Options {
+			mark := "( )"
+			if e.InitialOption != nil && e.InitialOption.Value == option.Value {
+				mark = "(*)"
+			}
+			parts = append(parts, mark+" "+r.text(option.Text))
+		}
+		return strings.Join(parts, "  ")
+	case nil:
+		return ""
+	default:
+		return fmt.Sprintf("[%s]", element.ElementType())
+	}
+}
+
+func (r PreviewRenderer) renderRichText(elements []RichTextElement) string {
+	lines := make([]string, 0, len(elements))
+	for _, element := range elements {
+		switch e := element.(type) {
+		case *RichTextSection:
+			lines = append(lines, r.renderRichTextSection(e.Elements))
+		case *RichTextList:
+			for i, item := range e.Elements {
+				bullet := "•"
+				if e.Style == RTEListOrdered {
+					bullet = strconv.Itoa(e.Offset+i+1) + "."
+				}
+				lines = append(lines, strings.Repeat("  ", e.Indent)+bullet+" "+r.renderRichText([]RichTextElement{item}))
+			}
+		case *RichTextQuote:
+			for _, line := range strings.Split(r.renderRichTextSection(e.Elements), "\n") {
+				lines = append(lines, "> "+line)
+			}
+		case *RichTextPreformatted:
+			lines = append(lines, "```\n"+r.renderRichTextSection(e.Elements)+"\n```")
+		}
+	}
+	return strings.Join(lines, "\n")
+}
+
+func (r PreviewRenderer) renderRichTextSection(elements []RichTextSectionElement) string {
+	var b strings.Builder
+	for _, element := range elements {
+		switch e := element.(type) {
+		case *RichTextSectionTextElement:
+			if e.Style != nil && e.Style.Bold {
+				b.WriteString(r.style(ansiBold, e.Text))
+			} else if e.Style != nil && e.Style.Italic {
+				b.WriteString(r.style(ansiItalic, e.Text))
+			} else {
+				b.WriteString(e.Text)
+			}
+		case *RichTextSectionUserElement:
+			b.WriteString("@" + e.UserID)
+		case *RichTextSectionChannelElement:
+			b.WriteString("#" + e.ChannelID)
+		case *RichTextSectionUserGroupElement:
+			b.WriteString("@" + e.UsergroupID)
+		case *RichTextSectionTeamElement:
+			b.WriteString(e.TeamID)
+		case *RichTextSectionEmojiElement:
+			b.WriteString(":" + e.Name + ":")
+		case *RichTextSectionLinkElement:
+			if e.Text != "" {
+				b.WriteString(e.Text + " (" + e.URL + ")")
+			} else {
+				b.WriteString(e.URL)
+			}
+		case *RichTextSectionDateElement:
+			b.WriteString(e.Timestamp.Time().UTC().Format("Mon Jan _2 15:04 MST"))
+		case *RichTextSectionBroadcastElement:
+			b.WriteString("@" + e.Range)
+		case *RichTextSectionColorElement:
+			b.WriteString(e.Value)
+		}
+	}
+	return b.String()
+}
+
+func (r PreviewRenderer) renderAttachment(attachment Attachment) string {
+	var lines []string
+	if attachment.Pretext != "" {
+		lines = append(lines, r.mrkdwn(attachment.Pretext))
+	}
+
+	var body []string
+	if attachment.Title != "" {
+		body = append(body, r.style(ansiBold, attachment.Title))
+	}
+	if attachment.Text != "" {
+		body = append(body, r.mrkdwn(attachment.Text))
+	}
+	for _, field := range attachment.Fields {
+		body = append(body, r.style(ansiBold, field.Title)+": "+r.mrkdwn(field.Value))
+	}
+	if len(attachment.Blocks.BlockSet) > 0 {
+		body = append(body, r.RenderBlocks(attachment.Blocks.BlockSet...))
+	}
+	if attachment.Footer != "" {
+		body = append(body, r.style(ansiDim, r.mrkdwn(attachment.Footer)))
+	}
+	if len(body) == 0 && attachment.Fallback != "" {
+		body = append(body, attachment.Fallback)
+	}
+
+	for _, line := range strings.Split(strings.Join(body, "\n"), "\n") {
+		lines = append(lines, "| "+line)
+	}
+	return strings.Join(lines, "\n")
+}
+
+func (r PreviewRenderer) text(obj *TextBlockObject) string {
+	if obj == nil {
+		return ""
+	}
+	if obj.Type == MarkdownType {
+		return r.mrkdwn(obj.Text)
+	}
+	return obj.Text
+}
+
+func (r PreviewRenderer) textOr(obj *TextBlockObject, fallback string) string {
+	if obj == nil || obj.Text == "" {
+		return fallback
+	}
+	return r.text(obj)
+}
+
+// mrkdwn resolves the mentions and links of mrkdwn text, and applies the bold and italic
+// formatting when rendering for a terminal.
+func (r PreviewRenderer) mrkdwn(text string) string {
+	text = previewEntityPattern.ReplaceAllStringFunc(text, func(entity string) string {
+		m := previewEntityPattern.FindStringSubmatch(entity)
+		target, label := m[1], m[2]
+
+		switch {
+		case strings.HasPrefix(target, "@"), strings.HasPrefix(target, "#"):
+			if label != "" {
+				return target[:1] + label
+			}
+			return target
+		case strings.HasPrefix(target, "!"):
+			if label != "" {
+				return label
+			}
+			return "@" + strings.TrimPrefix(target, "!")
+		case label != "":
+			return label + " (" + target + ")"
+		default:
+			return target
+		}
+	})
+
+	if r.ANSI {
+		text = previewBoldPattern.ReplaceAllString(text, ansiBold+"$1"+ansiReset)
+		text = previewItalicPattern.ReplaceAllString(text, ansiItalic+"$1"+ansiReset)
+	}
+
+	return strings.NewReplacer("&lt;", "<", "&gt;", ">", "&amp;", "&").Replace(text)
+}
+
+func (r PreviewRenderer) style(code, s string) string {
+	if !r.ANSI || s == "" {
+		return s
+	}
+	return code + s + ansiReset
+}
@@ -0,0 +1,75 @@
+package slack
+
+import (
+	"testing"
+)
+
+func TestPreviewRendererRenderMessage(t *testing.T) {
+	msg := Message{}
+	msg.Text = "fallback"
+	msg.Blocks = Blocks{BlockSet: []Block{
+		NewHeaderBlock(NewTextBlockObject(PlainTextType, "Deploy", false, false), ""),
+		NewSectionBlock(
+			NewTextBlockObject(MarkdownType, "*web* deployed by <@U123|alice> &amp; <https://example.com/logs|logs>", false, false),
+			[]*TextBlockObject{
+				NewTextBlockObject(MarkdownType, "*Env*\nproduction", false, false),
+			},
+			NewButtonBlockElement("rollback", "v1", NewTextBlockObject(PlainTextType, "Rollback", false, false)).WithStyle(StyleDanger),
+		),
+		NewDividerBlock(),
+		NewActionBlock("",
+			NewButtonBlockElement("ack", "", NewTextBlockObject(PlainTextType, "Ack", false, false)),
+			NewOptionsSelectBlockElement(OptTypeStatic, NewTextBlockObject(PlainTextType, "Pick a version", false, false), "version"),
+		),
+		NewContextBlock("", NewTextBlockObject(MarkdownType, "<!here> took 2m", false, false), NewImageBlockElement("https://example.com/a.png", "avatar")),
+	}}
+	msg.Attachments = []Attachment{{Title: "Changes", Fields: []AttachmentField{{Title: "Commits", Value: "3"}}}}
+
+	want := "Deploy\n" +
+		"*web* deployed by @alice & logs (https://example.com/logs)\n" +
+		"*Env*\nproduction\n" +
+		"[Rollback]\n" +
+		"──────────\n" +
+		"[Ack] [Pick a version ▾]\n" +
+		"@here took 2m · [avatar]\n" +
+		"| Changes\n" +
+		"| Commits: 3"
+
+	if got := (PreviewRenderer{Width: 10}).RenderMessage(msg); got != want {
+		t.Errorf("expected:\n%s\ngot:\n%s", want, got)
+	}
+
+	ansi := PreviewRenderer{ANSI: true}.RenderBlocks(msg.Blocks.BlockSet[:2]...)
+	wantANSI := "\x1b[1mDeploy\x1b[0m\n" +
+		"\x1b[1mweb\x1b[0m deployed by @alice & logs (https://example.com/logs)\n" +
+		"\x1b[1mEnv\x1b[0m\nproduction\n" +
+		"\x1b[31m[Rollback]\x1b[0m"
+	if ansi != wantANSI {
+		t.Errorf("expected:\n%q\ngot:\n%q", wantANSI, ansi)
+	}
+}
+
+func TestPreviewRendererRichText(t *testing.T) {
+	block := NewRichTextBlock("",
+		NewRichTextSection(NewRichTextSectionTextElement("Steps ", nil), NewRichTextSectionUserElement("U123", nil)),
+		NewRichTextList(RTEListOrdered, 1,
+			NewRichTextSection(NewRichTextSectionTextElement("build", nil)),
+			NewRichTextSection(NewRichTextSectionTextElement("ship", nil)),
+		),
+		NewRichTextQuote(NewRichTextSectionTextElement("quoted", nil)),
+		NewRichTextPreformatted(NewRichTextSectionTextElement("go test", nil)),
+	)
+
+	want := "Steps @U123\n  1. build\n  2. ship\n> quoted\n```\ngo test\n```"
+	if got := (PreviewRenderer{}).RenderBlocks(block); got != want {
+		t.Errorf("expected:\n%s\ngot:\n%s", want, got)
+	}
+}
+
+func TestPreviewRendererText(t *testing.T) {
+	msg := Message{}
+	msg.Text = "hello <#C123|general>, see <https://example.com>"
+	if got := (PreviewRenderer{}).RenderMessage(msg); got != "hello #general, see https://example.com" {
+		t.Errorf("unexpected preview: %q", got)
+	}
+}
@@ -0,0 +1,195 @@
+package slack
+
+import (
+	"context"
+	"net/http"
+	"sort"
+	"strconv"
+	"sync"
+	"time"
+)
+
+// RateLimitState is what the client observed of the rate limit of a web API method.
+type RateLimitState struct {
+	Method string
+	// LastLimited is when the method last responded with HTTP 429.
+	LastLimited time.Time
+	// RetryAfter is the backoff requested by that response.
+	RetryAfter time.Duration
+	// Count is the number of rate limited responses received for the method.
+	Count int
+}
+
+// RetryAt returns the time after which the method may be called again.
+func (t RateLimitState) RetryAt() time.Time {
+	return t.LastLimited.Add(t.RetryAfter)
+}
+
+// Limited reports whether the method is still backing off at the given time.
+func (t RateLimitState) Limited(now time.Time) bool {
+	return now.Before(t.RetryAt())
+}
+
+type rateLimits struct {
+	mu      sync.Mutex
+	methods map[string]RateLimitState
+}
+
+func (t *rateLimits) observe(method string, resp *http.Response, now time.Time) {
+	if t == nil || resp.StatusCode != http.StatusTooManyRequests {
+		return
+	}
+
+	retry, _ := strconv.ParseInt(resp.Header.Get("Retry-After"), 10, 64)
+
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	if t.methods == nil {
+		t.methods = map[string]RateLimitState{}
+	}
+
+	state := t.methods[method]
+	state.Method = method
+	state.LastLimited = now
+	state.RetryAfter = time.Duration(retry) * time.Second
+	state.Count++
+	t.methods[method] = state
+}
+
+// RateLimitState returns the rate limit state observed for the given method, e.g. chat.postMessage.
+// The boolean is false when the method has never been rate limited.
+func (api *Client) RateLimitState(method string) (RateLimitState, bool) {
+	if api.rateLimits == nil {
+		return RateLimitState{}, false
+	}
+
+	api.rateLimits.mu.Lock()
+	defer api.rateLimits.mu.Unlock()
+
+	state, ok := api.rateLimits.methods[method]
+	return state, ok
+}
+
+// RateLimitStates returns the rate limit state of every method that has been rate limited, sorted by method.
+func (api *Client) RateLimitStates() []RateLimitState {
+	if api.rateLimits == nil {
+		return nil
+	}
+
+	api.rateLimits.mu.Lock()
+	defer api.rateLimits.mu.Unlock()
+
+	states := make([]RateLimitState, 0, len(api.rateLimits.methods))
+	for _, state := range api.rateLimits.methods {
+		states = append(states, state)
+	}
+	sort.Slice(states, func(i, j int) bool { return states[i].Method < states[j].Method })
+
+	return states
+}
+
+// ChannelRateLimiter spaces out the messages posted to each channel, slack asks apps not to
+// post more than one message per second to a channel. Messages over the limit wait for
+// their turn instead of failing.
+type ChannelRateLimiter struct {
+	interval time.Duration
+	burst    float64
+
+	mu       sync.Mutex
+	channels map[string]*channelBucket
+}
+
+type channelBucket struct {
+	tokens float64
+	last   time.Time
+}
+
+// NewChannelRateLimiter allows burst messages per channel at once, then one message per interval.
+func NewChannelRateLimiter(interval time.Duration, burst int) *ChannelRateLimiter {
+	if burst < 1 {
+		burst = 1
+	}
+
+	return &ChannelRateLimiter{
+		interval: interval,
+		burst:    float64(burst),
+		channels: map[string]*channelBucket{},
+	}
+}
+
+// Wait blocks until a message may be posted to the channel, or ctx is done.
+func (t *ChannelRateLimiter) Wait(ctx context.Context, channelID string) error {
+	return t.wait(ctx, channelID, systemClock{})
+}
+
+func (t *ChannelRateLimiter) wait(ctx context.Context, channelID string, clock Clock) error {
+	delay := t.reserve(channelID, clock.Now())
+	if delay <= 0 {
+		return nil
+	}
+
+	select {
+	case <-ctx.Done():
+		t.cancel(channelID)
+		return ctx.Err()
+	case <-clock.After(delay):
+		return nil
+	}
+}
+
+// reserve takes a token from the bucket of the channel, returning how long to wait for it.
+func (t *ChannelRateLimiter) reserve(channelID string, now time.Time) time.Duration {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	b, ok := t.channels[channelID]
+	if !ok {
+		b = &channelBucket{tokens: t.burst, last: now}
+		t.channels[channelID] = b
+	}
+	t.refill(b, now)
+	b.tokens--
+
+	if b.tokens >= 0 || t.interval <= 0 {
+		return 0
+	}
+
+	return time.Duration(-b.tokens * float64(t.interval))
+}
+
+// cancel gives back a token that was reserved but not used.
+func (t *ChannelRateLimiter) cancel(channelID string) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	if b, ok := t.channels[channelID]; ok {
+		b.tokens++
+	}
+}
+
+func (t *ChannelRateLimiter) refill(b *channelBucket, now time.Time) {
+	if t.interval > 0 && now.After(b.last) {
+		b.tokens += float64(now.Sub(b.last)) / float64(t.interval)
+	}
+	if b.tokens > t.burst || t.interval <= 0 {
+		b.tokens = t.burst
+	}
+	b.last = now
+
+	// forget the channels which are back to a full bucket so idle channels don't accumulate.
+	if len(t.channels) > 1000 {
+		for id, other := range t.channels {
+			if other != b && other.tokens+float64(now.Sub(other.last))/float64(t.interval) >= t.burst {
+				delete(t.channels, id)
+			}
+		}
+	}
+}
+
+// OptionChannelRateLimit makes PostMessage wait for the limiter before posting to a channel.
+func OptionChannelRateLimit(limiter *ChannelRateLimiter) func(*Client) {
+	return func(c *Client) {
+		c.channelLimiter = limiter
+	}
+}
@@ -0,0 +1,114 @@
+package slack
+
+import (
+	"context"
+	"net/http"
+	"net/url"
+	"testing"
+	"time"
+)
+
+func TestRateLimitState(t *testing.T) {
+	http.HandleFunc("/ratelimit.limited", func(rw http.ResponseWriter, r *http.Request) {
+		rw.Header().Set("Retry-After", "30")
+		rw.WriteHeader(http.StatusTooManyRequests)
+	})
+	http.HandleFunc("/ratelimit.ok", okJSONHandler)
+
+	once.Do(startServer)
+	api := New("testing-token", OptionAPIURL("http://"+serverAddr+"/"))
+
+	if _, ok := api.RateLimitState("ratelimit.limited"); ok {
+		t.Fatal("Expected no rate limit state before any request")
+	}
+
+	before := time.Now()
+	for i := 0; i < 2; i++ {
+		err := api.postMethod(context.Background(), "ratelimit.limited", url.Values{}, &SlackResponse{})
+		if _, ok := err.(*RateLimitedError); !ok {
+			t.Fatalf("Expected a RateLimitedError, got %#v", err)
+		}
+	}
+	if err := api.postMethod(context.Background(), "ratelimit.ok", url.Values{}, &SlackResponse{}); err != nil {
+		t.Fatalf("Unexpected error: %s", err)
+	}
+
+	state, ok := api.RateLimitState("ratelimit.limited")
+	if !ok {
+		t.Fatal("Expected a rate limit state")
+	}
+	if state.Count != 2 || state.RetryAfter != 30*time.Second || state.LastLimited.Before(before) {
+		t.Errorf("Unexpected state: %#v", state)
+	}
+	if !state.Limited(time.Now()) || state.Limited(time.Now().Add(time.Minute)) {
+		t.Errorf("Unexpected Limited result for %#v", state)
+	}
+
+	states := api.RateLimitStates()
+	if len(states) != 1 || states[0].Method != "ratelimit.limited" {
+		t.Errorf("Unexpected states: %#v", states)
+	}
+}
+
+func TestChannelRateLimiterReserve(t *testing.T) {
+	limiter := NewChannelRateLimiter(time.Second, 2)
+	now := time.Now()
+
+	expected := []time.Duration{0, 0, time.Second, 2 * time.Second}
+	for i, want := range expected {
+		if got := limiter.reserve("C1", now); got != want {
+			t.Errorf("reservation %d: expected %s, got %s", i, want, got)
+		}
+	}
+
+	if got := limiter.reserve("C2", now); got != 0 {
+		t.Errorf("Expected other channels not to wait, got %s", got)
+	}
+
+	// after the backlog is consumed the bucket refills.
+	if got := limiter.reserve("C1", now.Add(5*time.Second)); got != 0 {
+		t.Errorf("Expected the bucket to have refilled, got %s", got)
+	}
+}
+
+func TestChannelRateLimiterWaitCancelled(t *testing.T) {
+	limiter := NewChannelRateLimiter(time.Hour, 1)
+	if err := limiter.Wait(context.Background(), "C1"); err != nil {
+		t.Fatalf("Unexpected error: %s", err)
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Millisecond)
+	defer cancel()
+	if err := limiter.Wait(ctx, "C1"); err != context.DeadlineExceeded {
+		t.Fatalf("Expected %v, got %v", context.DeadlineExceeded, err)
+	}
+}
+
+func TestOptionChannelRateLimit(t *testing.T) {
+	http.DefaultServeMux = new(http.ServeMux)
+	defer func() { http.DefaultServeMux = new(http.ServeMux) }()
+
+	var posted []time.Time
+	http.HandleFunc("/chat.postMessage", func(rw http.ResponseWriter, r *http.Request) {
+		posted = append(posted, time.Now())
+		rw.Header().Set("Content-Type", "application/json")
+		rw.Write([]byte(`{"ok": true, "channel": "C1", "ts": "1.0"}`))
+	})
+
+	once.Do(startServer)
+	interval := 50 * time.Millisecond
+	api := New("testing-token", OptionAPIURL("http://"+serverAddr+"/"), OptionChannelRateLimit(NewChannelRateLimiter(interval, 1)))
+
+	for i := 0; i < 3; i++ {
+		if _, _, err := api.PostMessage("C1", MsgOptionText("hello", false)); err != nil {
+			t.Fatalf("Unexpected error: %s", err)
+		}
+	}
+
+	if len(posted) != 3 {
+		t.Fatalf("Expected 3 messages, got %d", len(posted))
+	}
+	if elapsed := posted[2].Sub(posted[0]); elapsed < 2*interval-5*time.Millisecond {
+		t.Errorf("Expected the messages to be spaced out, took %s", elapsed)
+	}
+}
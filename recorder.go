@@ -0,0 +1,173 @@
+package slack
+
+import (
+	"bufio"
+	"bytes"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"net/http"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+)
+
+// Recording is a request received from slack, such as an interaction, event or slash command payload.
+type Recording struct {
+	Time        time.Time `json:"time"`
+	Path        string    `json:"path"`
+	ContentType string    `json:"content_type"`
+	Body        string    `json:"body"`
+}
+
+// Recorder is an http.Handler which records the requests it receives before passing them on,
+// so that payloads reported from production can be replayed in tests with Replay.
+type Recorder struct {
+	next     http.Handler
+	redactor *Redactor
+
+	mu  sync.Mutex
+	enc *json.Encoder
+	err error
+}
+
+// NewRecorder records the requests received by next to w, one JSON encoded Recording per line.
+// Verification tokens and OAuth tokens found in the payloads are redacted.
+func NewRecorder(w io.Writer, next http.Handler) *Recorder {
+	return NewRecorderWithRedactor(w, next, TokenRedactor())
+}
+
+// NewRecorderWithRedactor records the requests received by next to w, redacting the payloads with redactor.
+// A nil redactor records the payloads as they are.
+func NewRecorderWithRedactor(w io.Writer, next http.Handler, redactor *Redactor) *Recorder {
+	return &Recorder{
+		next:     next,
+		redactor: redactor,
+		enc:      json.NewEncoder(w),
+	}
+}
+
+// ServeHTTP records the request then calls the wrapped handler. Failing to record a request
+// does not prevent it from being handled, see Err.
+func (t *Recorder) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	body, err := ioutil.ReadAll(r.Body)
+	r.Body.Close()
+	r.Body = ioutil.NopCloser(bytes.NewReader(body))
+
+	if err == nil {
+		contentType := r.Header.Get("Content-Type")
+		err = t.record(Recording{
+			Time:        time.Now(),
+			Path:        r.URL.Path,
+			ContentType: contentType,
+			Body:        t.redactor.Redact(contentType, body),
+		})
+	}
+
+	if err != nil {
+		t.mu.Lock()
+		t.err = err
+		t.mu.Unlock()
+	}
+
+	t.next.ServeHTTP(w, r)
+}
+
+// Err returns the last error encountered while recording.
+func (t *Recorder) Err() error {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	return t.err
+}
+
+func (t *Recorder) record(rec Recording) error {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	return t.enc.Encode(rec)
+}
+
+// ReplayResult is the response of the handler to a replayed Recording.
+type ReplayResult struct {
+	Recording  Recording
+	StatusCode int
+	Body       []byte
+}
+
+// Replay reads the recordings written by a Recorder and sends them to the handler, in order.
+// When signingSecret isn't empty the requests are signed with it, so handlers verifying the
+// signature of requests accept them.
+func Replay(r io.Reader, handler http.Handler, signingSecret string) ([]ReplayResult, error) {
+	var results []ReplayResult
+
+	scanner := bufio.NewScanner(r)
+	scanner.Buffer(make([]byte, 64*1024), 10*1024*1024)
+	for scanner.Scan() {
+		if len(bytes.TrimSpace(scanner.Bytes())) == 0 {
+			continue
+		}
+
+		var rec Recording
+		if err := json.Unmarshal(scanner.Bytes(), &rec); err != nil {
+			return results, err
+		}
+
+		req, err := http.NewRequest(http.MethodPost, rec.Path, strings.NewReader(rec.Body))
+		if err != nil {
+			return results, err
+		}
+		req.Header.Set("Content-Type", rec.ContentType)
+		if signingSecret != "" {
+			signRequest(req.Header, signingSecret, rec.Body)
+		}
+
+		w := &replayResponseWriter{header: http.Header{}}
+		handler.ServeHTTP(w, req)
+		if w.code == 0 {
+			w.code = http.StatusOK
+		}
+		results = append(results, ReplayResult{
+			Recording:  rec,
+			StatusCode: w.code,
+			Body:       w.body.Bytes(),
+		})
+	}
+
+	return results, scanner.Err()
+}
+
+// replayResponseWriter captures the response of a handler to a replayed recording.
+type replayResponseWriter struct {
+	header http.Header
+	code   int
+	body   bytes.Buffer
+}
+
+func (w *replayResponseWriter) Header() http.Header {
+	return w.header
+}
+
+func (w *replayResponseWriter) WriteHeader(code int) {
+	if w.code == 0 {
+		w.code = code
+	}
+}
+
+func (w *replayResponseWriter) Write(b []byte) (int, error) {
+	w.WriteHeader(http.StatusOK)
+	return w.body.Write(b)
+}
+
+// signRequest sets the signature headers slack would send for body.
+func signRequest(header http.Header, secret, body string) {
+	timestamp := strconv.FormatInt(time.Now().Unix(), 10)
+	hash := hmac.New(sha256.New, []byte(secret))
+	fmt.Fprintf(hash, "v0:%s:%s", timestamp, body)
+
+	header.Set(hTimestamp, timestamp)
+	header.Set(hSignature, "v0="+hex.EncodeToString(hash.Sum(nil)))
+}
@@ -0,0 +1,94 @@
+package slack
+
+import (
+	"bytes"
+	"encoding/json"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"strings"
+	"testing"
+)
+
+func TestRecorderAndReplay(t *testing.T) {
+	payload := `{"type":"block_actions","token":"verification-token","user":{"id":"U1"},"trigger_id":"1.2.3","actions":[{"block_id":"b1","action_id":"approve","value":"xoxb-1234-abcd"}]}`
+	form := url.Values{"payload": {payload}}.Encode()
+	event := `{"token":"verification-token","type":"event_callback","authorizations":[{"bot_token":"xoxb-1234-abcd"}],"event":{"type":"app_mention","text":"hello"}}`
+
+	var handled []string
+	handler := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		body, _ := ioutil.ReadAll(r.Body)
+		handled = append(handled, string(body))
+	})
+
+	var recordings bytes.Buffer
+	recorder := NewRecorder(&recordings, handler)
+
+	for _, req := range []*http.Request{
+		httptest.NewRequest(http.MethodPost, "/interactions", strings.NewReader(form)),
+		httptest.NewRequest(http.MethodPost, "/events", strings.NewReader(event)),
+	} {
+		if req.URL.Path == "/interactions" {
+			req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+		} else {
+			req.Header.Set("Content-Type", "application/json")
+		}
+		recorder.ServeHTTP(httptest.NewRecorder(), req)
+	}
+
+	if err := recorder.Err(); err != nil {
+		t.Fatalf("Unexpected error: %s", err)
+	}
+	if len(handled) != 2 || handled[0] != form || handled[1] != event {
+		t.Fatalf("Expected the handler to receive the original bodies, got %v", handled)
+	}
+	if strings.Contains(recordings.String(), "verification-token") || strings.Contains(recordings.String(), "xoxb-1234") {
+		t.Fatalf("Expected tokens to be redacted, got %s", recordings.String())
+	}
+
+	var replayed []InteractionCallback
+	verified := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		sv, err := NewSecretsVerifier(r.Header, validSigningSecret)
+		if err != nil {
+			w.WriteHeader(http.StatusUnauthorized)
+			return
+		}
+		body, _ := ioutil.ReadAll(r.Body)
+		sv.Write(body)
+		if err = sv.Ensure(); err != nil {
+			w.WriteHeader(http.StatusUnauthorized)
+			return
+		}
+
+		if r.URL.Path == "/interactions" {
+			values, _ := url.ParseQuery(string(body))
+			var callback InteractionCallback
+			if err := json.Unmarshal([]byte(values.Get("payload")), &callback); err != nil {
+				w.WriteHeader(http.StatusBadRequest)
+				return
+			}
+			replayed = append(replayed, callback)
+		}
+		w.Write([]byte("ok"))
+	})
+
+	results, err := Replay(bytes.NewReader(recordings.Bytes()), verified, validSigningSecret)
+	if err != nil {
+		t.Fatalf("Unexpected error: %s", err)
+	}
+	if len(results) != 2 {
+		t.Fatalf("Expected 2 results, got %d", len(results))
+	}
+	for _, result := range results {
+		if result.StatusCode != http.StatusOK {
+			t.Errorf("%s: expected status 200, got %d", result.Recording.Path, result.StatusCode)
+		}
+	}
+	if len(replayed) != 1 || replayed[0].User.ID != "U1" || replayed[0].Token != "REDACTED" {
+		t.Fatalf("Unexpected replayed interactions: %#v", replayed)
+	}
+	if actions := replayed[0].ActionCallback.BlockActions; len(actions) != 1 || actions[0].Value != "REDACTED" {
+		t.Errorf("Unexpected actions: %#v", actions)
+	}
+}
@@ -0,0 +1,150 @@
+package slack
+
+import (
+	"encoding/json"
+	"mime"
+	"net/url"
+	"regexp"
+	"strings"
+)
+
+const redacted = "REDACTED"
+
+var (
+	tokenPattern   = regexp.MustCompile(`xox[a-z]-[A-Za-z0-9-]+`)
+	emailPattern   = regexp.MustCompile(`[A-Za-z0-9._%+-]+@[A-Za-z0-9.-]+\.[A-Za-z]{2,}`)
+	filePattern    = regexp.MustCompile(`https://files\.slack\.com/[^\s"'<>|]+`)
+	textFieldNames = map[string]bool{"text": true, "fallback": true, "pretext": true, "preview": true}
+)
+
+// Redactor removes sensitive data from payloads before they are logged or recorded.
+// The zero value, like a nil Redactor, leaves payloads untouched, each field enables a rule.
+type Redactor struct {
+	// Tokens redacts the fields named token or ending with _token, and anything looking like a token.
+	Tokens bool
+	// Emails redacts email addresses.
+	Emails bool
+	// Text redacts the text of messages and attachments.
+	Text bool
+	// FileURLs redacts the private URLs, permalinks and thumbnails of files.
+	FileURLs bool
+	// Fields redacts the values of the fields with these names.
+	Fields []string
+}
+
+// TokenRedactor only redacts tokens, it's used by default by NewRecorder.
+func TokenRedactor() *Redactor {
+	return &Redactor{Tokens: true}
+}
+
+// StrictRedactor enables every rule, for regulated environments.
+func StrictRedactor() *Redactor {
+	return &Redactor{Tokens: true, Emails: true, Text: true, FileURLs: true}
+}
+
+// Redact redacts a form encoded or JSON payload, other content types are treated as text.
+// Form encoded values named payload are expected to hold JSON, as in interaction requests.
+func (r *Redactor) Redact(contentType string, body []byte) string {
+	if r == nil {
+		return string(body)
+	}
+
+	mediaType, _, _ := mime.ParseMediaType(contentType)
+	switch mediaType {
+	case "application/json":
+		return r.redactJSON(string(body))
+	case "application/x-www-form-urlencoded":
+		values, err := url.ParseQuery(string(body))
+		if err != nil {
+			break
+		}
+		for key, vs := range values {
+			for i, v := range vs {
+				switch {
+				case r.redactsField(key):
+					vs[i] = redacted
+				case key == "payload":
+					vs[i] = r.redactJSON(v)
+				default:
+					vs[i] = r.RedactString(v)
+				}
+			}
+		}
+		return values.Encode()
+	}
+
+	return r.RedactString(string(body))
+}
+
+// RedactString redacts the tokens, email addresses and file URLs found in free text, according to the rules.
+func (r *Redactor) RedactString(s string) string {
+	if r == nil {
+		return s
+	}
+	if r.Tokens {
+		s = tokenPattern.ReplaceAllString(s, redacted)
+	}
+	if r.Emails {
+		s = emailPattern.ReplaceAllString(s, redacted)
+	}
+	if r.FileURLs {
+		s = filePattern.ReplaceAllString(s, redacted)
+	}
+	return s
+}
+
+func (r *Redactor) redactJSON(s string) string {
+	var v interface{}
+	if err := json.Unmarshal([]byte(s), &v); err != nil {
+		return r.RedactString(s)
+	}
+
+	b, err := json.Marshal(r.redactValue(v))
+	if err != nil {
+		return r.RedactString(s)
+	}
+
+	return string(b)
+}
+
+func (r *Redactor) redactValue(v interface{}) interface{} {
+	switch v := v.(type) {
+	case map[string]interface{}:
+		for key, value := range v {
+			if _, ok := value.(string); ok && r.redactsField(key) {
+				v[key] = redacted
+				continue
+			}
+			v[key] = r.redactValue(value)
+		}
+		return v
+	case []interface{}:
+		for i := range v {
+			v[i] = r.redactValue(v[i])
+		}
+		return v
+	case string:
+		return r.RedactString(v)
+	default:
+		return v
+	}
+}
+
+func (r *Redactor) redactsField(key string) bool {
+	switch {
+	case r.Tokens && (key == "token" || strings.HasSuffix(key, "_token")):
+		return true
+	case r.Text && textFieldNames[key]:
+		return true
+	case r.FileURLs && (strings.HasPrefix(key, "url_private") || strings.HasPrefix(key, "permalink") || strings.HasPrefix(key, "thumb_")):
+		return true
+	}
+
+	for _, field := range r.Fields {
+		if key == field {
+			return true
+		}
+	}
+
+	return false
+}
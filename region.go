@@ -0,0 +1,75 @@
+package slack
+
+import (
+	"fmt"
+	"net/http"
+	"strings"
+)
+
+// OptionRegion sends the requests of the client to the API hosts of domain instead of
+// slack.com, e.g. "slack-gov.com". The admin requests of DisableUser and the Invite
+// methods are sent to <team>.<domain>. Websocket and file download URLs returned
+// by slack are used as they are.
+func OptionRegion(domain string) func(*Client) {
+	return func(c *Client) {
+		c.endpoint = "https://" + domain + "/api/"
+		c.adminURLFormat = "https://%s." + domain + "/api/users.admin.%s?t=%d"
+	}
+}
+
+// OptionAllowedHosts restricts the hosts the client sends requests to, and the RTM
+// connects to, requests to other hosts fail with a *HostNotAllowedError. It ensures no
+// call escapes to a host of another region. A host starting with a dot, e.g. ".slack.com",
+// allows its subdomains.
+func OptionAllowedHosts(hosts ...string) func(*Client) {
+	return func(c *Client) {
+		c.allowedHosts = append(c.allowedHosts, hosts...)
+	}
+}
+
+// HostNotAllowedError is returned for requests to a host not allowed by OptionAllowedHosts.
+type HostNotAllowedError struct {
+	Host string
+}
+
+func (e *HostNotAllowedError) Error() string {
+	return fmt.Sprintf("host %s is not allowed", e.Host)
+}
+
+// checkHost returns a *HostNotAllowedError when the host isn't allowed.
+func (api *Client) checkHost(host string) error {
+	if len(api.allowedHosts) == 0 {
+		return nil
+	}
+
+	host = strings.ToLower(host)
+	for _, allowed := range api.allowedHosts {
+		allowed = strings.ToLower(allowed)
+		if host == allowed || (strings.HasPrefix(allowed, ".") && strings.HasSuffix(host, allowed)) {
+			return nil
+		}
+	}
+	return &HostNotAllowedError{Host: host}
+}
+
+// hostClient refuses the requests to hosts which aren't allowed.
+type hostClient struct {
+	client httpClient
+	api    *Client
+}
+
+func (c hostClient) Do(req *http.Request) (*http.Response, error) {
+	if err := c.api.checkHost(req.URL.Hostname()); err != nil {
+		return nil, err
+	}
+	return c.client.Do(req)
+}
+
+// configureAllowedHosts wraps the http client to refuse the requests to hosts which aren't allowed.
+func (api *Client) configureAllowedHosts() {
+	if len(api.allowedHosts) == 0 {
+		return
+	}
+
+	api.httpclient = hostClient{client: api.httpclient, api: api}
+}
@@ -0,0 +1,50 @@
+package slack
+
+import (
+	"bytes"
+	"net/http"
+	"net/url"
+	"testing"
+)
+
+func TestOptionRegion(t *testing.T) {
+	api := New(validToken, OptionRegion("slack-gov.com"))
+	if api.endpoint != "https://slack-gov.com/api/" {
+		t.Errorf("unexpected endpoint %s", api.endpoint)
+	}
+	if api.adminURLFormat != "https://%s.slack-gov.com/api/users.admin.%s?t=%d" {
+		t.Errorf("unexpected admin URL format %s", api.adminURLFormat)
+	}
+}
+
+func TestOptionAllowedHosts(t *testing.T) {
+	http.DefaultServeMux = new(http.ServeMux)
+	defer func() { http.DefaultServeMux = new(http.ServeMux) }()
+
+	http.HandleFunc("/auth.test", func(rw http.ResponseWriter, r *http.Request) {
+		rw.Header().Set("Content-Type", "application/json")
+		rw.Write([]byte(`{"ok": true}`))
+	})
+	once.Do(startServer)
+
+	u, err := url.Parse("http://" + serverAddr + "/")
+	if err != nil {
+		t.Fatal(err)
+	}
+	api := New(validToken, OptionAPIURL(u.String()), OptionAllowedHosts(u.Hostname(), ".slack-gov.com"))
+
+	if _, err := api.AuthTest(); err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	err = api.GetFile("https://files.slack.com/files-pri/T1-F1/report.csv", &bytes.Buffer{})
+	if hostErr, ok := err.(*HostNotAllowedError); !ok || hostErr.Host != "files.slack.com" {
+		t.Errorf("expected a *HostNotAllowedError, got %v", err)
+	}
+
+	for host, allowed := range map[string]bool{"files.slack-gov.com": true, "SLACK-GOV.com": false, "slack.com": false, "evilslack-gov.com": false} {
+		if err := api.checkHost(host); (err == nil) != allowed {
+			t.Errorf("%s: expected allowed to be %t, got %v", host, allowed, err)
+		}
+	}
+}
@@ -2,6 +2,7 @@ package slack
 
 import (
 	"context"
+	"net/http"
 	"net/url"
 	"sync"
 	"time"
@@ -91,6 +92,28 @@ func RTMOptionDialer(d *websocket.Dialer) RTMOption {
 	}
 }
 
+// RTMOptionDialHeader adds headers to the websocket handshake of the RTM connection,
+// e.g. the headers required by an egress proxy.
+func RTMOptionDialHeader(header http.Header) RTMOption {
+	return func(rtm *RTM) {
+		rtm.dialHeader = header
+	}
+}
+
+// RTMOptionCompression negotiates permessage-deflate compression of the RTM connection.
+func RTMOptionCompression(b bool) RTMOption {
+	return func(rtm *RTM) {
+		rtm.compression = b
+	}
+}
+
+// RTMOptionHandshakeTimeout sets how long the websocket handshake of the RTM connection may take.
+func RTMOptionHandshakeTimeout(d time.Duration) RTMOption {
+	return func(rtm *RTM) {
+		rtm.handshakeTimeout = d
+	}
+}
+
 // RTMOptionPingInterval determines how often to deliver a ping message to slack.
 func RTMOptionPingInterval(d time.Duration) RTMOption {
 	return func(rtm *RTM) {
@@ -121,6 +144,7 @@ func (api *Client) NewRTM(options ...RTMOption) *RTM {
 		forcePing:        make(chan bool),
 		idGen:            NewSafeID(1),
 		mu:               &sync.Mutex{},
+		health:           newRTMHealth(),
 	}
 
 	for _, opt := range options {
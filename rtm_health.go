@@ -0,0 +1,111 @@
+package slack
+
+import (
+	"sync"
+	"time"
+)
+
+// RTMHealth describes the state of a managed RTM connection, see RTM.Health.
+type RTMHealth struct {
+	Connected      bool
+	ConnectedSince time.Time
+	// Reconnects is the number of connections established after the first one.
+	Reconnects    int
+	PingsSent     int
+	PongsReceived int
+	// DroppedPongs counts the pings which weren't answered within the ping interval.
+	DroppedPongs int
+	LastPong     time.Time
+	LastLatency  time.Duration
+	// AverageLatency is an exponentially weighted moving average of the ping round trips.
+	AverageLatency time.Duration
+}
+
+// rtmHealth tracks the health of an RTM connection, it's shared by copies of the RTM.
+type rtmHealth struct {
+	mu      sync.Mutex
+	state   RTMHealth
+	pending map[int]time.Time
+}
+
+func newRTMHealth() *rtmHealth {
+	return &rtmHealth{pending: map[int]time.Time{}}
+}
+
+func (h *rtmHealth) snapshot() RTMHealth {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	return h.state
+}
+
+func (h *rtmHealth) connected(connectionCount int, at time.Time) {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	h.state.Connected = true
+	h.state.ConnectedSince = at
+	h.state.Reconnects = connectionCount
+}
+
+func (h *rtmHealth) disconnected() {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	h.state.Connected = false
+	h.pending = map[int]time.Time{}
+}
+
+// pingSent records a ping and returns the pings that went unanswered for longer than timeout.
+func (h *rtmHealth) pingSent(id int, at time.Time, timeout time.Duration) (dropped []PongMissedEvent) {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	for pid, sent := range h.pending {
+		if at.Sub(sent) < timeout {
+			continue
+		}
+		delete(h.pending, pid)
+		dropped = append(dropped, PongMissedEvent{ID: pid, SentAt: sent})
+	}
+
+	h.state.PingsSent++
+	h.state.DroppedPongs += len(dropped)
+	h.pending[id] = at
+	return dropped
+}
+
+// pongReceived records the pong and returns the round trip of the ping it answers, if it's known.
+func (h *rtmHealth) pongReceived(replyTo int, at time.Time) (time.Duration, bool) {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	h.state.PongsReceived++
+	h.state.LastPong = at
+
+	sent, ok := h.pending[replyTo]
+	if !ok {
+		return 0, false
+	}
+	delete(h.pending, replyTo)
+
+	latency := at.Sub(sent)
+	h.state.LastLatency = latency
+	if h.state.AverageLatency == 0 {
+		h.state.AverageLatency = latency
+	} else {
+		h.state.AverageLatency += (latency - h.state.AverageLatency) / 5
+	}
+
+	return latency, true
+}
+
+// Health returns the current health of the connection.
+func (rtm *RTM) Health() RTMHealth {
+	return rtm.health.snapshot()
+}
+
+// RTMOptionHealthEvents enables the pong_missed events, sent on IncomingEvents
+// whenever a ping isn't answered within the ping interval.
+func RTMOptionHealthEvents(b bool) RTMOption {
+	return func(rtm *RTM) {
+		rtm.healthEvents = b
+	}
+}
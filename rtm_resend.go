@@ -0,0 +1,85 @@
+package slack
+
+import "fmt"
+
+// DroppedMessageEvent is sent on IncomingEvents, as outgoing_dropped, when an outgoing
+// message failed to be sent and won't be sent again: either the resend buffer is full, and
+// ErrorObj is the send error, or the RTM was disconnected, and ErrorObj is ErrRTMClosed.
+type DroppedMessageEvent struct {
+	Message  OutgoingMessage
+	ErrorObj error
+}
+
+func (e *DroppedMessageEvent) Error() string {
+	return fmt.Sprintf("outgoing message %d dropped: %s", e.Message.ID, e.ErrorObj)
+}
+
+// RTMOptionResendBuffer keeps up to size outgoing messages which failed to be sent, e.g. while
+// the connection is being lost, and sends them again in order once reconnected. A failed send
+// isn't retried by itself, it waits for the reconnection, which backs off exponentially.
+// Messages which don't fit in the buffer, or are still buffered when the RTM is disconnected,
+// are reported with a DroppedMessageEvent instead of an OutgoingErrorEvent.
+func RTMOptionResendBuffer(size int) RTMOption {
+	return func(rtm *RTM) {
+		if size <= 0 {
+			rtm.resend = nil
+			return
+		}
+		rtm.resend = &rtmResendBuffer{size: size}
+	}
+}
+
+// rtmResendBuffer holds the outgoing messages to send again, it's only used by the goroutine
+// managing the connection.
+type rtmResendBuffer struct {
+	size    int
+	pending []OutgoingMessage
+}
+
+// add buffers the message, reporting false when the buffer is full.
+func (b *rtmResendBuffer) add(msg OutgoingMessage) bool {
+	if len(b.pending) >= b.size {
+		return false
+	}
+	b.pending = append(b.pending, msg)
+	return true
+}
+
+// take empties the buffer, returning the messages in the order they were sent.
+func (b *rtmResendBuffer) take() []OutgoingMessage {
+	pending := b.pending
+	b.pending = nil
+	return pending
+}
+
+// resendPending sends the buffered messages, the messages which failed to be sent again are
+// buffered back for the next connection.
+func (rtm *RTM) resendPending() error {
+	if rtm.resend == nil {
+		return nil
+	}
+
+	pending := rtm.resend.take()
+	for i, msg := range pending {
+		rtm.Debugln("Resending message:", msg)
+		if err := rtm.sendWithDeadline(msg); err != nil {
+			rtm.resend.pending = append(rtm.resend.pending, pending[i:]...)
+			return err
+		}
+	}
+	return nil
+}
+
+// dropPending reports the buffered messages as dropped, once the RTM is disconnected.
+func (rtm *RTM) dropPending() {
+	if rtm.resend == nil {
+		return
+	}
+
+	for _, msg := range rtm.resend.take() {
+		rtm.IncomingEvents <- RTMEvent{"outgoing_dropped", &DroppedMessageEvent{
+			Message:  msg,
+			ErrorObj: ErrRTMClosed,
+		}}
+	}
+}
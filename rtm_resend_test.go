@@ -0,0 +1,102 @@
+package slack
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/gorilla/websocket"
+)
+
+// dialTestWebsocket dials a websocket server passing the messages it receives to received.
+func dialTestWebsocket(t *testing.T, received chan<- OutgoingMessage) (*websocket.Conn, func()) {
+	upgrader := websocket.Upgrader{}
+	server := httptest.NewServer(http.HandlerFunc(func(rw http.ResponseWriter, r *http.Request) {
+		conn, err := upgrader.Upgrade(rw, r, nil)
+		if err != nil {
+			return
+		}
+		defer conn.Close()
+		for {
+			var msg OutgoingMessage
+			if err := conn.ReadJSON(&msg); err != nil {
+				return
+			}
+			received <- msg
+		}
+	}))
+
+	conn, _, err := websocket.DefaultDialer.Dial("ws"+strings.TrimPrefix(server.URL, "http"), nil)
+	if err != nil {
+		server.Close()
+		t.Fatalf("unexpected error: %s", err)
+	}
+	return conn, func() {
+		conn.Close()
+		server.Close()
+	}
+}
+
+func TestRTMResendBuffer(t *testing.T) {
+	received := make(chan OutgoingMessage, 10)
+	rtm := New("testing-token").NewRTM(RTMOptionResendBuffer(2))
+
+	// the writes fail on a closed connection.
+	conn, stop := dialTestWebsocket(t, received)
+	conn.Close()
+	rtm.conn = conn
+	for id := 1; id <= 3; id++ {
+		rtm.sendOutgoingMessage(OutgoingMessage{ID: id, Type: "message", Channel: "C1", Text: "hello"})
+	}
+	stop()
+
+	select {
+	case event := <-rtm.IncomingEvents:
+		dropped, ok := event.Data.(*DroppedMessageEvent)
+		if event.Type != "outgoing_dropped" || !ok || dropped.Message.ID != 3 {
+			t.Fatalf("expected message 3 to be dropped, got %#v", event)
+		}
+	default:
+		t.Fatal("expected a dropped message event")
+	}
+	if len(rtm.IncomingEvents) != 0 {
+		t.Errorf("expected the buffered messages not to be reported, got %d events", len(rtm.IncomingEvents))
+	}
+
+	// the buffered messages are sent once reconnected.
+	conn, stop = dialTestWebsocket(t, received)
+	defer stop()
+	rtm.conn = conn
+	if err := rtm.resendPending(); err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	for _, id := range []int{1, 2} {
+		if msg := <-received; msg.ID != id {
+			t.Errorf("expected message %d to be resent, got %d", id, msg.ID)
+		}
+	}
+	if len(rtm.resend.pending) != 0 {
+		t.Errorf("expected the buffer to be empty, got %d messages", len(rtm.resend.pending))
+	}
+}
+
+func TestRTMResendBufferDisconnected(t *testing.T) {
+	rtm := New("testing-token").NewRTM(RTMOptionResendBuffer(2))
+	rtm.resend.add(OutgoingMessage{ID: 1, Type: "message", Channel: "C1", Text: "hello"})
+
+	rtm.dropPending()
+
+	select {
+	case event := <-rtm.IncomingEvents:
+		dropped, ok := event.Data.(*DroppedMessageEvent)
+		if event.Type != "outgoing_dropped" || !ok || dropped.Message.ID != 1 || dropped.ErrorObj != ErrRTMClosed {
+			t.Fatalf("expected message 1 to be dropped, got %#v", event)
+		}
+	default:
+		t.Fatal("expected a dropped message event")
+	}
+	if len(rtm.resend.pending) != 0 {
+		t.Errorf("expected the buffer to be empty, got %d messages", len(rtm.resend.pending))
+	}
+}
@@ -0,0 +1,73 @@
+package slack
+
+import (
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/gorilla/websocket"
+)
+
+func TestRTMDialOptions(t *testing.T) {
+	base := &websocket.Dialer{HandshakeTimeout: time.Minute}
+	rtm := New("testing-token").NewRTM(
+		RTMOptionDialer(base),
+		RTMOptionCompression(true),
+		RTMOptionHandshakeTimeout(5*time.Second),
+		RTMOptionDialHeader(http.Header{"X-Egress-Route": {"slack"}, "Origin": {"https://example.com"}}),
+	)
+
+	dialer := rtm.rtmDialer()
+	if !dialer.EnableCompression || dialer.HandshakeTimeout != 5*time.Second {
+		t.Errorf("dial options were not applied: %+v", dialer)
+	}
+	if base.EnableCompression || base.HandshakeTimeout != time.Minute {
+		t.Error("expected the provided dialer to be left untouched")
+	}
+
+	header := rtm.upgradeHeader()
+	if header.Get("X-Egress-Route") != "slack" || header.Get("Origin") != "https://api.slack.com" {
+		t.Errorf("unexpected handshake headers: %v", header)
+	}
+}
+
+func TestRTMHealth(t *testing.T) {
+	var (
+		h     = newRTMHealth()
+		start = time.Unix(1500000000, 0)
+	)
+
+	h.connected(2, start)
+	h.pingSent(1, start, 30*time.Second)
+	h.pingSent(2, start.Add(10*time.Second), 30*time.Second)
+
+	if latency, ok := h.pongReceived(2, start.Add(10*time.Second+200*time.Millisecond)); !ok || latency != 200*time.Millisecond {
+		t.Errorf("unexpected latency: %v %t", latency, ok)
+	}
+
+	dropped := h.pingSent(3, start.Add(40*time.Second), 30*time.Second)
+	if len(dropped) != 1 || dropped[0].ID != 1 {
+		t.Errorf("expected ping 1 to be dropped, got %+v", dropped)
+	}
+
+	health := h.snapshot()
+	want := RTMHealth{
+		Connected:      true,
+		ConnectedSince: start,
+		Reconnects:     2,
+		PingsSent:      3,
+		PongsReceived:  1,
+		DroppedPongs:   1,
+		LastPong:       start.Add(10*time.Second + 200*time.Millisecond),
+		LastLatency:    200 * time.Millisecond,
+		AverageLatency: 200 * time.Millisecond,
+	}
+	if health != want {
+		t.Errorf("expected %+v, got %+v", want, health)
+	}
+
+	h.disconnected()
+	if _, ok := h.pongReceived(3, start.Add(41*time.Second)); ok {
+		t.Error("expected pending pings to be discarded on disconnect")
+	}
+}
@@ -0,0 +1,141 @@
+package slack
+
+import (
+	"context"
+	"sync"
+	"time"
+)
+
+// Recurrence describes when a scheduled job runs, expressed in local time.
+type Recurrence struct {
+	Hour   int
+	Minute int
+	// Weekdays restricts the job to the given days of the week, when empty the job runs every day.
+	Weekdays []time.Weekday
+}
+
+// Next returns the first occurrence of the recurrence strictly after t, in the provided location.
+func (r Recurrence) Next(t time.Time, loc *time.Location) time.Time {
+	local := t.In(loc)
+	for day := 0; day <= 7; day++ {
+		candidate := time.Date(local.Year(), local.Month(), local.Day()+day, r.Hour, r.Minute, 0, 0, loc)
+		if candidate.After(t) && r.runsOn(candidate.Weekday()) {
+			return candidate
+		}
+	}
+
+	// only reachable when Weekdays contains no valid days.
+	return time.Time{}
+}
+
+func (r Recurrence) runsOn(day time.Weekday) bool {
+	if len(r.Weekdays) == 0 {
+		return true
+	}
+
+	for _, d := range r.Weekdays {
+		if d == day {
+			return true
+		}
+	}
+
+	return false
+}
+
+// ScheduledFunc is invoked by the Scheduler, at is the occurrence that triggered it.
+type ScheduledFunc func(ctx context.Context, at time.Time)
+
+// Scheduler runs callbacks at a local time of day, allowing bots to respect
+// the timezones of the users they interact with.
+type Scheduler struct {
+	client *Client
+	wg     sync.WaitGroup
+
+	mu        sync.Mutex
+	locations map[string]*time.Location
+}
+
+// NewScheduler creates a scheduler which uses the client to look up user timezones.
+func NewScheduler(client *Client) *Scheduler {
+	return &Scheduler{
+		client:    client,
+		locations: map[string]*time.Location{},
+	}
+}
+
+// Schedule runs fn at every occurrence of r in loc until ctx is cancelled.
+func (s *Scheduler) Schedule(ctx context.Context, loc *time.Location, r Recurrence, fn ScheduledFunc) {
+	clock := s.client.currentClock()
+
+	s.wg.Add(1)
+	go func() {
+		defer s.wg.Done()
+		for {
+			now := clock.Now()
+			next := r.Next(now, loc)
+			if next.IsZero() {
+				return
+			}
+
+			select {
+			case <-ctx.Done():
+				return
+			case <-clock.After(next.Sub(now)):
+				fn(ctx, next)
+			}
+		}
+	}()
+}
+
+// ScheduleForUser runs fn at every occurrence of r in the timezone of the given user until ctx is cancelled.
+// The timezone is resolved once, using users.info.
+func (s *Scheduler) ScheduleForUser(ctx context.Context, userID string, r Recurrence, fn ScheduledFunc) error {
+	loc, err := s.UserLocation(ctx, userID)
+	if err != nil {
+		return err
+	}
+
+	s.Schedule(ctx, loc, r, fn)
+	return nil
+}
+
+// UserLocation returns the timezone of the user, results are cached for the lifetime of the scheduler.
+func (s *Scheduler) UserLocation(ctx context.Context, userID string) (*time.Location, error) {
+	s.mu.Lock()
+	loc, ok := s.locations[userID]
+	s.mu.Unlock()
+	if ok {
+		return loc, nil
+	}
+
+	user, err := s.client.GetUserInfoContext(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	loc = UserLocation(user)
+
+	s.mu.Lock()
+	s.locations[userID] = loc
+	s.mu.Unlock()
+
+	return loc, nil
+}
+
+// Wait blocks until all scheduled jobs have stopped.
+func (s *Scheduler) Wait() {
+	s.wg.Wait()
+}
+
+// UserLocation converts the timezone information of a user into a time.Location.
+// When the named zone isn't available on the system a fixed zone based on the
+// user's offset is returned instead.
+func UserLocation(user *User) *time.Location {
+	if user.TZ != "" {
+		if loc, err := time.LoadLocation(user.TZ); err == nil {
+			return loc
+		}
+	}
+
+	return time.FixedZone(user.TZLabel, user.TZOffset)
+}
@@ -0,0 +1,85 @@
+package slack
+
+import (
+	"context"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestRecurrenceNext(t *testing.T) {
+	loc := time.FixedZone("test", -7*60*60)
+	// Wednesday 2019-11-13 10:00 local
+	now := time.Date(2019, 11, 13, 10, 0, 0, 0, loc)
+
+	tests := map[string]struct {
+		recurrence Recurrence
+		expected   time.Time
+	}{
+		"later today": {
+			recurrence: Recurrence{Hour: 11, Minute: 30},
+			expected:   time.Date(2019, 11, 13, 11, 30, 0, 0, loc),
+		},
+		"already passed today": {
+			recurrence: Recurrence{Hour: 9},
+			expected:   time.Date(2019, 11, 14, 9, 0, 0, 0, loc),
+		},
+		"exactly now": {
+			recurrence: Recurrence{Hour: 10},
+			expected:   time.Date(2019, 11, 14, 10, 0, 0, 0, loc),
+		},
+		"weekdays only": {
+			recurrence: Recurrence{Hour: 9, Weekdays: []time.Weekday{time.Monday}},
+			expected:   time.Date(2019, 11, 18, 9, 0, 0, 0, loc),
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			assert.Equal(t, test.expected, test.recurrence.Next(now, loc))
+		})
+	}
+
+	assert.True(t, Recurrence{Weekdays: []time.Weekday{7}}.Next(now, loc).IsZero())
+}
+
+func TestSchedulerUserLocation(t *testing.T) {
+	http.DefaultServeMux = new(http.ServeMux)
+	defer func() { http.DefaultServeMux = new(http.ServeMux) }()
+
+	calls := 0
+	http.HandleFunc("/users.info", func(rw http.ResponseWriter, r *http.Request) {
+		calls++
+		getUserInfo(rw, r)
+	})
+
+	once.Do(startServer)
+	api := New("testing-token", OptionAPIURL("http://"+serverAddr+"/"))
+	scheduler := NewScheduler(api)
+
+	for i := 0; i < 2; i++ {
+		loc, err := scheduler.UserLocation(context.Background(), "UXXXXXXXX")
+		if err != nil {
+			t.Fatalf("Unexpected error: %s", err)
+		}
+		_, offset := time.Date(2019, 7, 1, 0, 0, 0, 0, loc).Zone()
+		assert.Equal(t, -25200, offset)
+	}
+	assert.Equal(t, 1, calls)
+}
+
+func TestUserLocationFallback(t *testing.T) {
+	loc := UserLocation(&User{TZ: "Not/AZone", TZLabel: "Somewhere", TZOffset: 3600})
+	_, offset := time.Now().In(loc).Zone()
+	assert.Equal(t, 3600, offset)
+}
+
+func TestSchedulerStopsOnCancel(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	scheduler := NewScheduler(New("testing-token"))
+	scheduler.Schedule(ctx, time.UTC, Recurrence{}, func(context.Context, time.Time) {})
+	cancel()
+	scheduler.Wait()
+}
@@ -154,3 +154,176 @@ func (api *Client) SearchMessagesContext(ctx context.Context, query string, para
 	}
 	return &response.SearchMessages, nil
 }
+
+// SavedSearch is a search.messages query along with its parameters, it can be stored, e.g.
+// encoded as JSON, and run again with SearchMessagesPaginated.
+type SavedSearch struct {
+	Query string `json:"query"`
+	// Sort is score or timestamp, score when empty.
+	Sort string `json:"sort,omitempty"`
+	// SortDirection is asc or desc, desc when empty.
+	SortDirection string `json:"sort_dir,omitempty"`
+	Highlight     bool   `json:"highlight,omitempty"`
+	// Count is the number of matches per page, DEFAULT_SEARCH_COUNT when zero.
+	Count int `json:"count,omitempty"`
+	// MaxResults stops the pagination after this many matches, zero pages until exhaustion.
+	MaxResults int `json:"max_results,omitempty"`
+}
+
+func (s SavedSearch) parameters(page int) SearchParameters {
+	params := NewSearchParameters()
+	params.Highlight = s.Highlight
+	params.Page = page
+	if s.Sort != "" {
+		params.Sort = s.Sort
+	}
+	if s.SortDirection != "" {
+		params.SortDirection = s.SortDirection
+	}
+	if s.Count > 0 {
+		params.Count = s.Count
+	}
+	return params
+}
+
+// SearchMatch is a message matching a search, its author and channel are only set when
+// resolved, see SearchOptionResolveUsers and SearchOptionResolveChannels.
+type SearchMatch struct {
+	SearchMessage
+	Author       *User
+	Conversation *Channel
+}
+
+// SearchOption options for the SearchMessagesPaginated method call.
+type SearchOption func(*SearchIterator)
+
+// SearchOptionResolveUsers looks up the author of each match with users.info, once per user.
+func SearchOptionResolveUsers() SearchOption {
+	return func(t *SearchIterator) {
+		t.users = map[string]*User{}
+	}
+}
+
+// SearchOptionResolveChannels looks up the channel of each match with conversations.info, once per channel.
+func SearchOptionResolveChannels() SearchOption {
+	return func(t *SearchIterator) {
+		t.channels = map[string]*Channel{}
+	}
+}
+
+// SearchIterator allows for paginating over the matches of a saved search.
+type SearchIterator struct {
+	Matches []SearchMatch
+	// Total is the number of messages matching the search, as reported by the last page.
+	Total int
+
+	search   SavedSearch
+	page     int
+	pages    int
+	seen     int
+	users    map[string]*User
+	channels map[string]*Channel
+	c        *Client
+}
+
+// SearchMessagesPaginated pages through the matches of the search, use it like GetUsersPaginated:
+// call Next until Done reports the pagination has completed.
+func (api *Client) SearchMessagesPaginated(search SavedSearch, options ...SearchOption) SearchIterator {
+	t := SearchIterator{
+		search: search,
+		c:      api,
+	}
+
+	for _, opt := range options {
+		opt(&t)
+	}
+
+	return t
+}
+
+// Done checks if the pagination has completed
+func (SearchIterator) Done(err error) bool {
+	return err == errPaginationComplete
+}
+
+// Failure checks if pagination failed.
+func (t SearchIterator) Failure(err error) error {
+	if t.Done(err) {
+		return nil
+	}
+
+	return err
+}
+
+// Next fetches the next page of matches.
+func (t SearchIterator) Next(ctx context.Context) (_ SearchIterator, err error) {
+	if t.c == nil || (t.page > 0 && t.page >= t.pages) || (t.search.MaxResults > 0 && t.seen >= t.search.MaxResults) {
+		return t, errPaginationComplete
+	}
+
+	result, err := t.c.SearchMessagesContext(ctx, t.search.Query, t.search.parameters(t.page+1))
+	if err != nil {
+		return t, err
+	}
+
+	messages := result.Matches
+	if remaining := t.search.MaxResults - t.seen; t.search.MaxResults > 0 && len(messages) > remaining {
+		messages = messages[:remaining]
+	}
+
+	matches := make([]SearchMatch, 0, len(messages))
+	for _, message := range messages {
+		match := SearchMatch{SearchMessage: message}
+		if match.Author, err = t.resolveUser(ctx, message.User); err != nil {
+			return t, err
+		}
+		if match.Conversation, err = t.resolveChannel(ctx, message.Channel.ID); err != nil {
+			return t, err
+		}
+		matches = append(matches, match)
+	}
+
+	t.Matches = matches
+	t.Total = result.Paging.Total
+	t.page = result.Paging.Page
+	t.pages = result.Paging.Pages
+	t.seen += len(matches)
+	if len(matches) == 0 {
+		// guards against looping over an empty page reporting more pages.
+		t.pages = t.page
+	}
+
+	return t, nil
+}
+
+func (t SearchIterator) resolveUser(ctx context.Context, userID string) (*User, error) {
+	if t.users == nil || userID == "" {
+		return nil, nil
+	}
+	if user, ok := t.users[userID]; ok {
+		return user, nil
+	}
+
+	user, err := t.c.GetUserInfoContext(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+	t.users[userID] = user
+	return user, nil
+}
+
+func (t SearchIterator) resolveChannel(ctx context.Context, channelID string) (*Channel, error) {
+	if t.channels == nil || channelID == "" {
+		return nil, nil
+	}
+	if channel, ok := t.channels[channelID]; ok {
+		return channel, nil
+	}
+
+	channel, err := t.c.GetConversationInfoContext(ctx, channelID, false)
+	if err != nil {
+		return nil, err
+	}
+	t.channels[channelID] = channel
+	return channel, nil
+}
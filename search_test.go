@@ -0,0 +1,86 @@
+package slack
+
+import (
+	"context"
+	"net/http"
+	"testing"
+)
+
+func searchMessagesHandler(rw http.ResponseWriter, r *http.Request) {
+	rw.Header().Set("Content-Type", "application/json")
+	if r.FormValue("sort") != "timestamp" || r.FormValue("sort_dir") != "asc" || r.FormValue("highlight") != "1" || r.FormValue("count") != "2" {
+		rw.Write([]byte(`{"ok": false, "error": "unexpected_parameters"}`))
+		return
+	}
+
+	switch r.FormValue("page") {
+	case "":
+		rw.Write([]byte(`{"ok": true, "messages": {"total": 3, "paging": {"count": 2, "total": 3, "page": 1, "pages": 2}, "matches": [
+			{"type": "message", "user": "U1", "ts": "1.0", "text": "deploy failed", "channel": {"id": "C1", "name": "ops"}},
+			{"type": "message", "user": "U2", "ts": "2.0", "text": "deploy done", "channel": {"id": "C1", "name": "ops"}}
+		]}}`))
+	case "2":
+		rw.Write([]byte(`{"ok": true, "messages": {"total": 3, "paging": {"count": 2, "total": 3, "page": 2, "pages": 2}, "matches": [
+			{"type": "message", "user": "U1", "ts": "3.0", "text": "deploy again", "channel": {"id": "C1", "name": "ops"}}
+		]}}`))
+	default:
+		rw.Write([]byte(`{"ok": false, "error": "page_out_of_range"}`))
+	}
+}
+
+func TestSearchMessagesPaginated(t *testing.T) {
+	http.DefaultServeMux = new(http.ServeMux)
+	defer func() { http.DefaultServeMux = new(http.ServeMux) }()
+
+	lookups := 0
+	http.HandleFunc("/search.messages", searchMessagesHandler)
+	http.HandleFunc("/users.info", func(rw http.ResponseWriter, r *http.Request) {
+		lookups++
+		rw.Header().Set("Content-Type", "application/json")
+		rw.Write([]byte(`{"ok": true, "user": {"id": "` + r.FormValue("user") + `", "name": "user-` + r.FormValue("user") + `"}}`))
+	})
+
+	once.Do(startServer)
+	api := New("testing-token", OptionAPIURL("http://"+serverAddr+"/"))
+	search := SavedSearch{Query: "deploy", Sort: "timestamp", SortDirection: "asc", Highlight: true, Count: 2}
+
+	var (
+		texts []string
+		names []string
+		err   error
+	)
+	p := api.SearchMessagesPaginated(search, SearchOptionResolveUsers())
+	for err == nil {
+		if p, err = p.Next(context.Background()); err == nil {
+			for _, match := range p.Matches {
+				texts = append(texts, match.Text)
+				names = append(names, match.Author.Name)
+			}
+		}
+	}
+	if err = p.Failure(err); err != nil {
+		t.Fatalf("Unexpected error: %s", err)
+	}
+
+	if len(texts) != 3 || texts[2] != "deploy again" || p.Total != 3 {
+		t.Errorf("Unexpected matches: %v", texts)
+	}
+	if len(names) != 3 || names[0] != "user-U1" || names[1] != "user-U2" || names[2] != "user-U1" {
+		t.Errorf("Unexpected authors: %v", names)
+	}
+	if lookups != 2 {
+		t.Errorf("Expected each user to be looked up once, got %d lookups", lookups)
+	}
+
+	search.MaxResults = 1
+	p, err = api.SearchMessagesPaginated(search).Next(context.Background())
+	if err != nil {
+		t.Fatalf("Unexpected error: %s", err)
+	}
+	if len(p.Matches) != 1 || p.Matches[0].Author != nil {
+		t.Errorf("Unexpected matches: %#v", p.Matches)
+	}
+	if _, err = p.Next(context.Background()); !p.Done(err) {
+		t.Errorf("Expected the pagination to stop at the maximum, got %v", err)
+	}
+}
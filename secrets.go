@@ -0,0 +1,120 @@
+package slack
+
+import (
+	"context"
+	"net/http"
+	"net/url"
+	"sync"
+	"time"
+)
+
+// SecretProvider supplies a secret, such as a token or a signing secret, which may be
+// fetched from an external store (Vault, a KMS, ...) and may change over time.
+type SecretProvider interface {
+	Secret(ctx context.Context) (string, error)
+}
+
+// SecretProviderFunc adapts a function into a SecretProvider.
+type SecretProviderFunc func(ctx context.Context) (string, error)
+
+// Secret calls f(ctx).
+func (f SecretProviderFunc) Secret(ctx context.Context) (string, error) {
+	return f(ctx)
+}
+
+// StaticSecret is a SecretProvider that always returns the same secret.
+type StaticSecret string
+
+// Secret returns the secret.
+func (s StaticSecret) Secret(context.Context) (string, error) {
+	return string(s), nil
+}
+
+// CachedSecretProvider caches the secret of another provider for a fixed duration,
+// after which the secret is fetched again, picking up rotated secrets.
+type CachedSecretProvider struct {
+	provider SecretProvider
+	ttl      time.Duration
+
+	mu      sync.Mutex
+	secret  string
+	expires time.Time
+}
+
+// NewCachedSecretProvider caches the secrets returned by the provider for ttl.
+func NewCachedSecretProvider(provider SecretProvider, ttl time.Duration) *CachedSecretProvider {
+	return &CachedSecretProvider{
+		provider: provider,
+		ttl:      ttl,
+	}
+}
+
+// Secret returns the cached secret, fetching it from the underlying provider when it has expired.
+func (t *CachedSecretProvider) Secret(ctx context.Context) (string, error) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	if !t.expires.IsZero() && time.Now().Before(t.expires) {
+		return t.secret, nil
+	}
+
+	secret, err := t.provider.Secret(ctx)
+	if err != nil {
+		return "", err
+	}
+
+	t.secret, t.expires = secret, time.Now().Add(t.ttl)
+	return secret, nil
+}
+
+// Invalidate discards the cached secret, forcing the next call to Secret to fetch it again.
+// Useful right after a secret has been rotated.
+func (t *CachedSecretProvider) Invalidate() {
+	t.mu.Lock()
+	t.secret, t.expires = "", time.Time{}
+	t.mu.Unlock()
+}
+
+// OptionTokenProvider fetches the token of the client from the provider before each request
+// instead of using the token given to New. Wrap the provider in a CachedSecretProvider to
+// avoid hitting the secret store on every request.
+func OptionTokenProvider(provider SecretProvider) func(*Client) {
+	return func(c *Client) {
+		c.tokenProvider = provider
+	}
+}
+
+// currentToken returns the token requests are authenticated with.
+func (api *Client) currentToken(ctx context.Context) (string, error) {
+	if api.tokenProvider == nil {
+		return api.token, nil
+	}
+
+	return api.tokenProvider.Secret(ctx)
+}
+
+// authenticate replaces the client's token in values with the one from the token provider.
+// Values carrying a different token, e.g. the one being revoked by auth.revoke, are left untouched.
+func (api *Client) authenticate(ctx context.Context, values url.Values) error {
+	if api.tokenProvider == nil || values.Get("token") != api.token {
+		return nil
+	}
+
+	token, err := api.currentToken(ctx)
+	if err != nil {
+		return err
+	}
+
+	values.Set("token", token)
+	return nil
+}
+
+// NewSecretsVerifierFromProvider returns a SecretsVerifier using the signing secret supplied by the provider.
+func NewSecretsVerifierFromProvider(ctx context.Context, header http.Header, provider SecretProvider) (SecretsVerifier, error) {
+	secret, err := provider.Secret(ctx)
+	if err != nil {
+		return SecretsVerifier{}, err
+	}
+
+	return NewSecretsVerifier(header, secret)
+}
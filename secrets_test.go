@@ -0,0 +1,133 @@
+package slack
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/url"
+	"testing"
+	"time"
+)
+
+func TestCachedSecretProvider(t *testing.T) {
+	calls := 0
+	provider := NewCachedSecretProvider(SecretProviderFunc(func(context.Context) (string, error) {
+		calls++
+		return "secret-" + string(rune('0'+calls)), nil
+	}), time.Hour)
+
+	for i := 0; i < 3; i++ {
+		secret, err := provider.Secret(context.Background())
+		if err != nil {
+			t.Fatalf("Unexpected error: %s", err)
+		}
+		if secret != "secret-1" {
+			t.Fatalf("Expected secret-1, got %s", secret)
+		}
+	}
+
+	provider.Invalidate()
+	if secret, _ := provider.Secret(context.Background()); secret != "secret-2" {
+		t.Fatalf("Expected secret-2 after invalidation, got %s", secret)
+	}
+	if calls != 2 {
+		t.Fatalf("Expected 2 calls to the provider, got %d", calls)
+	}
+}
+
+func TestCachedSecretProviderError(t *testing.T) {
+	fail := errors.New("vault unavailable")
+	provider := NewCachedSecretProvider(SecretProviderFunc(func(context.Context) (string, error) {
+		return "", fail
+	}), time.Hour)
+
+	if _, err := provider.Secret(context.Background()); err != fail {
+		t.Fatalf("Expected %v, got %v", fail, err)
+	}
+}
+
+func TestOptionTokenProvider(t *testing.T) {
+	http.DefaultServeMux = new(http.ServeMux)
+	defer func() { http.DefaultServeMux = new(http.ServeMux) }()
+
+	var tokens []string
+	http.HandleFunc("/auth.test", func(rw http.ResponseWriter, r *http.Request) {
+		tokens = append(tokens, r.FormValue("token"))
+		rw.Header().Set("Content-Type", "application/json")
+		rw.Write([]byte(`{"ok": true}`))
+	})
+	http.HandleFunc("/auth.revoke", func(rw http.ResponseWriter, r *http.Request) {
+		tokens = append(tokens, r.FormValue("token"))
+		rw.Header().Set("Content-Type", "application/json")
+		rw.Write([]byte(`{"ok": true, "revoked": true}`))
+	})
+
+	once.Do(startServer)
+	current := "xoxb-first"
+	api := New("", OptionAPIURL("http://"+serverAddr+"/"), OptionTokenProvider(SecretProviderFunc(func(context.Context) (string, error) {
+		return current, nil
+	})))
+
+	if _, err := api.AuthTest(); err != nil {
+		t.Fatalf("Unexpected error: %s", err)
+	}
+	current = "xoxb-rotated"
+	if _, err := api.AuthTest(); err != nil {
+		t.Fatalf("Unexpected error: %s", err)
+	}
+	if _, err := api.SendAuthRevoke("xoxp-other"); err != nil {
+		t.Fatalf("Unexpected error: %s", err)
+	}
+
+	expected := []string{"xoxb-first", "xoxb-rotated", "xoxp-other"}
+	if len(tokens) != len(expected) {
+		t.Fatalf("Expected tokens %v, got %v", expected, tokens)
+	}
+	for i := range expected {
+		if tokens[i] != expected[i] {
+			t.Fatalf("Expected tokens %v, got %v", expected, tokens)
+		}
+	}
+}
+
+func TestOptionTokenProviderAdmin(t *testing.T) {
+	http.DefaultServeMux = new(http.ServeMux)
+	defer func() { http.DefaultServeMux = new(http.ServeMux) }()
+
+	var requests []string
+	http.HandleFunc("/api/users.admin.setInactive", func(rw http.ResponseWriter, r *http.Request) {
+		requests = append(requests, r.FormValue("token")+" "+r.FormValue("user")+" "+r.FormValue("reason"))
+		rw.Header().Set("Content-Type", "application/json")
+		rw.Write([]byte(`{"ok": true}`))
+	})
+
+	once.Do(startServer)
+	api := New("", OptionHTTPClient(localClient{}), OptionTokenProvider(StaticSecret("xoxp-admin")))
+
+	ctx := ContextWithParams(context.Background(), url.Values{"reason": {"offboarding"}})
+	if err := api.DisableUserContext(ctx, "acme", "U1"); err != nil {
+		t.Fatalf("Unexpected error: %s", err)
+	}
+	if len(requests) != 1 || requests[0] != "xoxp-admin U1 offboarding" {
+		t.Errorf("Unexpected requests: %v", requests)
+	}
+}
+
+func TestNewSecretsVerifierFromProvider(t *testing.T) {
+	header := http.Header{}
+	header.Set(hTimestamp, "1531420618")
+	header.Set(hSignature, "v0=a2114d57b48eac39b9ad189dd8316235a7b4a8d21a10bd27519666489c69b503")
+
+	sv, err := NewSecretsVerifierFromProvider(context.Background(), header, StaticSecret("8f742231b10e8888abcd99yyyzzz85a5"))
+	if err != ErrExpiredTimestamp {
+		t.Fatalf("Expected %v, got %v (%v)", ErrExpiredTimestamp, err, sv)
+	}
+
+	fail := errors.New("vault unavailable")
+	_, err = NewSecretsVerifierFromProvider(context.Background(), header, SecretProviderFunc(func(context.Context) (string, error) {
+		return "", fail
+	}))
+	if err != fail {
+		t.Fatalf("Expected %v, got %v", fail, err)
+	}
+}
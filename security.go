@@ -53,6 +53,11 @@ func unsafeSignatureVerifier(header http.Header, secret string) (_ SecretsVerifi
 
 // NewSecretsVerifier returns a SecretsVerifier object in exchange for an http.Header object and signing secret
 func NewSecretsVerifier(header http.Header, secret string) (sv SecretsVerifier, err error) {
+	return NewSecretsVerifierWithClock(header, secret, systemClock{})
+}
+
+// NewSecretsVerifierWithClock returns a SecretsVerifier checking the age of the request timestamp against the clock.
+func NewSecretsVerifierWithClock(header http.Header, secret string, clock Clock) (sv SecretsVerifier, err error) {
 	var (
 		timestamp int64
 	)
@@ -67,7 +72,7 @@ func NewSecretsVerifier(header http.Header, secret string) (sv SecretsVerifier,
 		return SecretsVerifier{}, err
 	}
 
-	diff := absDuration(time.Since(time.Unix(timestamp, 0)))
+	diff := absDuration(clock.Now().Sub(time.Unix(timestamp, 0)))
 	if diff > 5*time.Minute {
 		return SecretsVerifier{}, ErrExpiredTimestamp
 	}
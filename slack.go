@@ -2,11 +2,13 @@ package slack
 
 import (
 	"context"
+	"crypto/tls"
 	"fmt"
 	"log"
 	"net/http"
 	"net/url"
 	"os"
+	"path"
 )
 
 const (
@@ -16,6 +18,9 @@ const (
 	WEBAPIURLFormat = "https://%s.slack.com/api/users.admin.%s?t=%d"
 )
 
+// hRequestID is the header slack uses to identify a request, support will ask for it.
+const hRequestID = "X-Slack-Req-Id"
+
 // httpClient defines the minimal interface needed for an http.Client to be implemented.
 type httpClient interface {
 	Do(*http.Request) (*http.Response, error)
@@ -54,11 +59,32 @@ type authTestResponseFull struct {
 type ParamOption func(*url.Values)
 
 type Client struct {
-	token      string
-	endpoint   string
-	debug      bool
-	log        ilogger
-	httpclient httpClient
+	token         string
+	tokenProvider SecretProvider
+	endpoint      string
+	debug         bool
+	log           ilogger
+	httpclient    httpClient
+
+	responseHook func(ResponseInfo)
+	rateLimits   *rateLimits
+	strict       bool
+	errorBodyMax int
+	redactor     *Redactor
+
+	proxy         *url.URL
+	certificates  []tls.Certificate
+	tlsBase       *tls.Config
+	minTLSVersion uint16
+
+	channelLimiter *ChannelRateLimiter
+	broadcastGuard BroadcastGuard
+	headers        http.Header
+	jsonBody       bool
+	clock          Clock
+	circuitBreaker *CircuitBreaker
+	adminURLFormat string
+	allowedHosts   []string
 }
 
 // Option defines an option for a Client
@@ -85,6 +111,33 @@ func OptionLog(l logger) func(*Client) {
 	}
 }
 
+// OptionRedactor redacts the payloads written to the debug log, and the response bodies included in errors, with r,
+// so debugging can be enabled without logging tokens or user data.
+func OptionRedactor(r *Redactor) func(*Client) {
+	return func(c *Client) {
+		c.redactor = r
+	}
+}
+
+// OptionResponseHook registers a function called with the details of every response
+// received from the API, including error responses. Useful to log request IDs.
+func OptionResponseHook(hook func(ResponseInfo)) func(*Client) {
+	return func(c *Client) {
+		c.responseHook = hook
+	}
+}
+
+// DefaultErrorBodyLimit is the number of bytes of an unexpected, non 200, response body included in errors.
+const DefaultErrorBodyLimit = 512
+
+// OptionErrorBodyLimit sets how many bytes of the body of a non 200 response are included in the
+// returned error, which helps diagnosing responses from proxies or outages. Zero disables the capture.
+func OptionErrorBodyLimit(n int) func(*Client) {
+	return func(c *Client) {
+		c.errorBodyMax = n
+	}
+}
+
 // OptionAPIURL set the url for the client. only useful for testing.
 func OptionAPIURL(u string) func(*Client) {
 	return func(c *Client) { c.endpoint = u }
@@ -93,16 +146,24 @@ func OptionAPIURL(u string) func(*Client) {
 // New builds a slack client from the provided token and options.
 func New(token string, options ...Option) *Client {
 	s := &Client{
-		token:      token,
-		endpoint:   APIURL,
-		httpclient: &http.Client{},
-		log:        log.New(os.Stderr, "slack-go/slack", log.LstdFlags|log.Lshortfile),
+		token:          token,
+		endpoint:       APIURL,
+		adminURLFormat: WEBAPIURLFormat,
+		httpclient:     &http.Client{},
+		rateLimits:     &rateLimits{},
+		errorBodyMax:   DefaultErrorBodyLimit,
+		log:            log.New(os.Stderr, "slack-go/slack", log.LstdFlags|log.Lshortfile),
 	}
 
 	for _, opt := range options {
 		opt(s)
 	}
 
+	s.configureTransport()
+	s.configureHeaders()
+	s.configureCircuitBreaker()
+	s.configureAllowedHosts()
+
 	return s
 }
 
@@ -144,10 +205,77 @@ func (api *Client) Debug() bool {
 
 // post to a slack web method.
 func (api *Client) postMethod(ctx context.Context, path string, values url.Values, intf interface{}) error {
-	return postForm(ctx, api.httpclient, api.endpoint+path, values, intf, api)
+	if err := api.authenticate(ctx, values); err != nil {
+		return err
+	}
+	mergeParams(values, contextParams(ctx))
+	if err := postForm(ctx, api.httpclient, api.endpoint+path, values, intf, api); err != nil {
+		return err
+	}
+	return api.checkResponse(intf)
+}
+
+// post a JSON body to a slack web method.
+func (api *Client) postJSONMethod(ctx context.Context, path string, json []byte, intf interface{}) error {
+	token, err := api.currentToken(ctx)
+	if err != nil {
+		return err
+	}
+	if json, err = mergeJSONParams(ctx, json); err != nil {
+		return err
+	}
+	if err := postJSON(ctx, api.httpclient, api.endpoint+path, token, json, intf, api); err != nil {
+		return err
+	}
+	return api.checkResponse(intf)
 }
 
 // get a slack web method.
 func (api *Client) getMethod(ctx context.Context, path string, values url.Values, intf interface{}) error {
-	return getResource(ctx, api.httpclient, api.endpoint+path, values, intf, api)
+	if err := api.authenticate(ctx, values); err != nil {
+		return err
+	}
+	mergeParams(values, contextParams(ctx))
+	if err := getResource(ctx, api.httpclient, api.endpoint+path, values, intf, api); err != nil {
+		return err
+	}
+	return api.checkResponse(intf)
+}
+
+// ResponseInfo describes a response received from the slack API.
+type ResponseInfo struct {
+	// Method is the web API method which was called, e.g. chat.postMessage.
+	Method     string
+	StatusCode int
+	// RequestID is the value of the x-slack-req-id header, which slack support uses to find a request.
+	RequestID string
+	Header    http.Header
+}
+
+func (api *Client) debugRedactor() *Redactor {
+	return api.redactor
+}
+
+func (api *Client) maxErrorBody() int {
+	return api.errorBodyMax
+}
+
+func (api *Client) observeResponse(resp *http.Response) {
+	var method string
+	if resp.Request != nil {
+		method = path.Base(resp.Request.URL.Path)
+	}
+
+	api.rateLimits.observe(method, resp, api.currentClock().Now())
+
+	if api.responseHook == nil {
+		return
+	}
+
+	api.responseHook(ResponseInfo{
+		Method:     method,
+		StatusCode: resp.StatusCode,
+		RequestID:  resp.Header.Get(hRequestID),
+		Header:     resp.Header,
+	})
 }
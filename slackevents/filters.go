@@ -0,0 +1,133 @@
+package slackevents
+
+import (
+	"reflect"
+	"regexp"
+)
+
+// Filter reports whether an event should be handled.
+type Filter func(EventsAPIEvent) bool
+
+// Handler handles an event.
+type Handler func(EventsAPIEvent)
+
+// Filtered returns a handler which calls h only for the events accepted by all the filters.
+func Filtered(h Handler, filters ...Filter) Handler {
+	filter := And(filters...)
+	return func(e EventsAPIEvent) {
+		if filter(e) {
+			h(e)
+		}
+	}
+}
+
+// And accepts events accepted by all the filters.
+func And(filters ...Filter) Filter {
+	return func(e EventsAPIEvent) bool {
+		for _, f := range filters {
+			if !f(e) {
+				return false
+			}
+		}
+		return true
+	}
+}
+
+// Or accepts events accepted by any of the filters.
+func Or(filters ...Filter) Filter {
+	return func(e EventsAPIEvent) bool {
+		for _, f := range filters {
+			if f(e) {
+				return true
+			}
+		}
+		return false
+	}
+}
+
+// Not accepts events rejected by the filter.
+func Not(filter Filter) Filter {
+	return func(e EventsAPIEvent) bool {
+		return !filter(e)
+	}
+}
+
+// ByType accepts inner events of the given types, e.g. Message or AppMention.
+func ByType(types ...string) Filter {
+	return func(e EventsAPIEvent) bool {
+		return contains(types, e.InnerEvent.Type)
+	}
+}
+
+// ByChannel accepts inner events which happened in one of the channels.
+func ByChannel(channelIDs ...string) Filter {
+	return func(e EventsAPIEvent) bool {
+		return contains(channelIDs, EventChannel(e))
+	}
+}
+
+// ByUser accepts inner events triggered by one of the users.
+func ByUser(userIDs ...string) Filter {
+	return func(e EventsAPIEvent) bool {
+		return contains(userIDs, innerEventField(e, "User"))
+	}
+}
+
+// BySubtype accepts message events with one of the subtypes, use "" for plain messages.
+func BySubtype(subtypes ...string) Filter {
+	return func(e EventsAPIEvent) bool {
+		msg, ok := e.InnerEvent.Data.(*MessageEvent)
+		return ok && contains(subtypes, msg.SubType)
+	}
+}
+
+// ByText accepts inner events whose text matches the regular expression.
+func ByText(re *regexp.Regexp) Filter {
+	return func(e EventsAPIEvent) bool {
+		text := innerEventField(e, "Text")
+		return text != "" && re.MatchString(text)
+	}
+}
+
+// EventChannel returns the ID of the channel an inner event happened in, if any.
+func EventChannel(e EventsAPIEvent) string {
+	switch ev := e.InnerEvent.Data.(type) {
+	case *ReactionAddedEvent:
+		return ev.Item.Channel
+	case *ReactionRemovedEvent:
+		return ev.Item.Channel
+	}
+
+	return innerEventField(e, "Channel")
+}
+
+// innerEventField returns the string field of the inner event with the given name, if any.
+func innerEventField(e EventsAPIEvent, name string) string {
+	v := reflect.ValueOf(e.InnerEvent.Data)
+	for v.Kind() == reflect.Ptr || v.Kind() == reflect.Interface {
+		if v.IsNil() {
+			return ""
+		}
+		v = v.Elem()
+	}
+
+	if v.Kind() != reflect.Struct {
+		return ""
+	}
+
+	f := v.FieldByName(name)
+	if !f.IsValid() || f.Kind() != reflect.String {
+		return ""
+	}
+
+	return f.String()
+}
+
+func contains(values []string, s string) bool {
+	for _, v := range values {
+		if v == s {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,68 @@
+package slackevents
+
+import (
+	"encoding/json"
+	"regexp"
+	"testing"
+)
+
+func parseTestEvent(t *testing.T, inner string) EventsAPIEvent {
+	e, err := ParseEvent(json.RawMessage(`{
+		"token": "XXYYZZ",
+		"team_id": "TXXXXXXXX",
+		"api_app_id": "AXXXXXXXXX",
+		"type": "event_callback",
+		"event": `+inner+`
+	}`), OptionNoVerifyToken())
+	if err != nil {
+		t.Fatalf("Unexpected error: %s", err)
+	}
+	return e
+}
+
+func TestFilters(t *testing.T) {
+	message := parseTestEvent(t, `{"type": "message", "channel": "C1", "user": "U1", "text": "deploy api to prod", "ts": "1.0"}`)
+	edited := parseTestEvent(t, `{"type": "message", "subtype": "message_changed", "channel": "C1", "ts": "2.0"}`)
+	mention := parseTestEvent(t, `{"type": "app_mention", "channel": "C2", "user": "U2", "text": "<@B1> status"}`)
+	reaction := parseTestEvent(t, `{"type": "reaction_added", "user": "U1", "reaction": "tada", "item": {"type": "message", "channel": "C2", "ts": "1.0"}}`)
+
+	deploy := regexp.MustCompile(`^deploy (\w+)`)
+	tests := []struct {
+		name     string
+		filter   Filter
+		expected []bool
+	}{
+		{"type", ByType(Message), []bool{true, true, false, false}},
+		{"channel", ByChannel("C2"), []bool{false, false, true, true}},
+		{"user", ByUser("U1"), []bool{true, false, false, true}},
+		{"plain messages", BySubtype(""), []bool{true, false, false, false}},
+		{"text", ByText(deploy), []bool{true, false, false, false}},
+		{"or", Or(ByType(AppMention), ByText(deploy)), []bool{true, false, true, false}},
+		{"not", Not(ByChannel("C1")), []bool{false, false, true, true}},
+		{"and", And(ByUser("U1"), ByChannel("C2")), []bool{false, false, false, true}},
+	}
+
+	for _, test := range tests {
+		for i, e := range []EventsAPIEvent{message, edited, mention, reaction} {
+			if got := test.filter(e); got != test.expected[i] {
+				t.Errorf("%s: event %d: expected %t, got %t", test.name, i, test.expected[i], got)
+			}
+		}
+	}
+}
+
+func TestFiltered(t *testing.T) {
+	var handled []string
+	h := Filtered(func(e EventsAPIEvent) {
+		handled = append(handled, e.InnerEvent.Data.(*MessageEvent).Text)
+	}, ByType(Message), BySubtype(""), ByChannel("C1"))
+
+	h(parseTestEvent(t, `{"type": "message", "channel": "C1", "user": "U1", "text": "one"}`))
+	h(parseTestEvent(t, `{"type": "message", "channel": "C2", "user": "U1", "text": "two"}`))
+	h(parseTestEvent(t, `{"type": "message", "subtype": "bot_message", "channel": "C1", "text": "three"}`))
+	h(parseTestEvent(t, `{"type": "app_mention", "channel": "C1", "user": "U1", "text": "four"}`))
+
+	if len(handled) != 1 || handled[0] != "one" {
+		t.Errorf("Unexpected handled events: %v", handled)
+	}
+}
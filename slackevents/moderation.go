@@ -0,0 +1,178 @@
+package slackevents
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"strings"
+	"sync"
+
+	"github.com/slack-go/slack"
+	"github.com/slack-go/slack/slackutilsx"
+)
+
+// ModerationAction is taken for each message matching a moderation rule.
+type ModerationAction func(ctx context.Context, v Violation) error
+
+// ModerationRule matches messages by their text.
+type ModerationRule struct {
+	Name    string
+	Pattern *regexp.Regexp
+	Actions []ModerationAction
+}
+
+// NewKeywordRule matches messages containing any of the keywords as whole words, ignoring case.
+func NewKeywordRule(name string, keywords []string, actions ...ModerationAction) ModerationRule {
+	quoted := make([]string, 0, len(keywords))
+	for _, keyword := range keywords {
+		quoted = append(quoted, regexp.QuoteMeta(keyword))
+	}
+
+	return NewRegexpRule(name, regexp.MustCompile(`(?i)\b(?:`+strings.Join(quoted, "|")+`)\b`), actions...)
+}
+
+// NewRegexpRule matches messages whose text matches the regular expression.
+func NewRegexpRule(name string, pattern *regexp.Regexp, actions ...ModerationAction) ModerationRule {
+	return ModerationRule{
+		Name:    name,
+		Pattern: pattern,
+		Actions: actions,
+	}
+}
+
+// Violation is a message which matched a moderation rule.
+type Violation struct {
+	Rule    ModerationRule
+	Message *MessageEvent
+	// Match is the text matched by the pattern of the rule.
+	Match string
+}
+
+// Moderator checks message events against moderation rules and takes the actions of the
+// rules they match. Messages posted by bots are ignored, so that warnings and notifications
+// quoting a message don't trigger the rules again. Edited messages are checked again.
+type Moderator struct {
+	// OnError is called when an action fails, errors are ignored when nil.
+	OnError func(v Violation, err error)
+
+	mu    sync.RWMutex
+	rules []ModerationRule
+}
+
+// NewModerator creates a moderator applying the rules.
+func NewModerator(rules ...ModerationRule) *Moderator {
+	return &Moderator{rules: rules}
+}
+
+// AddRule registers a rule, it applies to the messages handled afterwards.
+func (m *Moderator) AddRule(rule ModerationRule) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.rules = append(m.rules, rule)
+}
+
+// Check returns the violations of the message, one per matching rule.
+func (m *Moderator) Check(msg *MessageEvent) []Violation {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+
+	var violations []Violation
+	for _, rule := range m.rules {
+		if match := rule.Pattern.FindString(msg.Text); match != "" {
+			violations = append(violations, Violation{Rule: rule, Message: msg, Match: match})
+		}
+	}
+
+	return violations
+}
+
+// Handle checks the message of the event and takes the actions of the matching rules,
+// in order. Events other than messages are ignored. The violations are returned.
+func (m *Moderator) Handle(ctx context.Context, e EventsAPIEvent) []Violation {
+	msg := moderatedMessage(e)
+	if msg == nil {
+		return nil
+	}
+
+	violations := m.Check(msg)
+	for _, v := range violations {
+		for _, action := range v.Rule.Actions {
+			if err := action(ctx, v); err != nil && m.OnError != nil {
+				m.OnError(v, err)
+			}
+		}
+	}
+
+	return violations
+}
+
+// Handler returns a handler moderating the events it's called with, e.g. combined with
+// Filtered and ByChannel to only moderate some channels.
+func (m *Moderator) Handler(ctx context.Context) Handler {
+	return func(e EventsAPIEvent) {
+		m.Handle(ctx, e)
+	}
+}
+
+// moderatedMessage returns the message of the event to check, nil when it shouldn't be.
+func moderatedMessage(e EventsAPIEvent) *MessageEvent {
+	msg, ok := e.InnerEvent.Data.(*MessageEvent)
+	if !ok {
+		return nil
+	}
+
+	switch msg.SubType {
+	case "", "thread_broadcast", "file_share":
+	case "message_changed":
+		if msg.Message == nil {
+			return nil
+		}
+		edited := *msg.Message
+		edited.Channel = msg.Channel
+		edited.ChannelType = msg.ChannelType
+		msg = &edited
+	default:
+		return nil
+	}
+
+	if msg.BotID != "" || msg.SubType == "bot_message" {
+		return nil
+	}
+
+	return msg
+}
+
+// DeleteMessageAction deletes the message, the client must use a token allowed to delete the
+// messages of other users, e.g. the user token of a workspace admin.
+func DeleteMessageAction(admin *slack.Client) ModerationAction {
+	return func(ctx context.Context, v Violation) error {
+		_, _, err := admin.DeleteMessageContext(ctx, v.Message.Channel, v.Message.TimeStamp)
+		return err
+	}
+}
+
+// WarnInThreadAction replies to the message with the warning, in its thread.
+func WarnInThreadAction(client *slack.Client, warning string) ModerationAction {
+	return func(ctx context.Context, v Violation) error {
+		thread := v.Message.ThreadTimeStamp
+		if thread == "" {
+			thread = v.Message.TimeStamp
+		}
+
+		_, _, err := client.PostMessageContext(ctx, v.Message.Channel, slack.MsgOptionText(warning, false), slack.MsgOptionTS(thread))
+		return err
+	}
+}
+
+// NotifyChannelAction reports the violation to a channel, e.g. one followed by the moderators.
+func NotifyChannelAction(client *slack.Client, channelID string) ModerationAction {
+	return func(ctx context.Context, v Violation) error {
+		// the quoted text is sanitized so the mentions it contains don't notify anyone again.
+		quote := strings.Replace(slackutilsx.SanitizeMrkdwn(v.Message.Text), "\n", "\n>", -1)
+		text := fmt.Sprintf("Message from <@%s> in <#%s> matched rule *%s*:\n>%s",
+			v.Message.User, v.Message.Channel, v.Rule.Name, quote)
+
+		_, _, err := client.PostMessageContext(ctx, channelID, slack.MsgOptionText(text, false))
+		return err
+	}
+}
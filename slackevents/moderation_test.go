@@ -0,0 +1,144 @@
+package slackevents
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"regexp"
+	"sync"
+	"testing"
+
+	"github.com/slack-go/slack"
+)
+
+func TestModeratorCheck(t *testing.T) {
+	moderator := NewModerator(NewKeywordRule("secrets", []string{"password", "api.key"}))
+	moderator.AddRule(NewRegexpRule("cards", regexp.MustCompile(`\b\d{4}-\d{4}-\d{4}-\d{4}\b`)))
+
+	tests := []struct {
+		text     string
+		expected []string
+	}{
+		{"my PASSWORD is hunter2", []string{"secrets"}},
+		{"passwords are overrated", nil},
+		{"the api.key and 4111-1111-1111-1111", []string{"secrets", "cards"}},
+		{"the apixkey", nil},
+	}
+
+	for _, test := range tests {
+		var rules []string
+		for _, v := range moderator.Check(&MessageEvent{Text: test.text}) {
+			rules = append(rules, v.Rule.Name)
+		}
+		if len(rules) != len(test.expected) {
+			t.Errorf("%q: expected %v, got %v", test.text, test.expected, rules)
+			continue
+		}
+		for i := range rules {
+			if rules[i] != test.expected[i] {
+				t.Errorf("%q: expected %v, got %v", test.text, test.expected, rules)
+			}
+		}
+	}
+}
+
+func TestModeratorHandle(t *testing.T) {
+	var (
+		mu      sync.Mutex
+		deleted []string
+		posted  []postedMessage
+	)
+	mux := http.NewServeMux()
+	mux.HandleFunc("/chat.delete", func(rw http.ResponseWriter, r *http.Request) {
+		mu.Lock()
+		defer mu.Unlock()
+		deleted = append(deleted, r.FormValue("token")+" "+r.FormValue("channel")+" "+r.FormValue("ts"))
+		rw.Header().Set("Content-Type", "application/json")
+		rw.Write([]byte(`{"ok": true}`))
+	})
+	mux.HandleFunc("/chat.postMessage", func(rw http.ResponseWriter, r *http.Request) {
+		mu.Lock()
+		defer mu.Unlock()
+		posted = append(posted, postedMessage{channel: r.FormValue("channel"), threadTS: r.FormValue("thread_ts"), text: r.FormValue("text")})
+		rw.Header().Set("Content-Type", "application/json")
+		rw.Write([]byte(`{"ok": true, "channel": "C1", "ts": "2.0"}`))
+	})
+	server := httptest.NewServer(mux)
+	defer server.Close()
+
+	bot := slack.New("xoxb-bot", slack.OptionAPIURL(server.URL+"/"))
+	admin := slack.New("xoxp-admin", slack.OptionAPIURL(server.URL+"/"))
+
+	moderator := NewModerator(NewKeywordRule("secrets", []string{"password"},
+		DeleteMessageAction(admin),
+		WarnInThreadAction(bot, "Please don't share passwords."),
+		NotifyChannelAction(bot, "CMOD"),
+	))
+
+	message := parseTestEvent(t, `{"type": "message", "channel": "C1", "user": "U1", "text": "the password is hunter2", "ts": "1.0"}`)
+	if violations := moderator.Handle(context.Background(), message); len(violations) != 1 || violations[0].Match != "password" {
+		t.Fatalf("Unexpected violations: %#v", violations)
+	}
+
+	if len(deleted) != 1 || deleted[0] != "xoxp-admin C1 1.0" {
+		t.Errorf("Unexpected deletions: %v", deleted)
+	}
+	if len(posted) != 2 {
+		t.Fatalf("Expected 2 messages, got %v", posted)
+	}
+	if posted[0] != (postedMessage{channel: "C1", threadTS: "1.0", text: "Please don't share passwords."}) {
+		t.Errorf("Unexpected warning: %#v", posted[0])
+	}
+	if posted[1] != (postedMessage{channel: "CMOD", text: "Message from <@U1> in <#C1> matched rule *secrets*:\n>the password is hunter2"}) {
+		t.Errorf("Unexpected notification: %#v", posted[1])
+	}
+
+	ignored := []EventsAPIEvent{
+		parseTestEvent(t, `{"type": "message", "subtype": "bot_message", "channel": "C1", "bot_id": "B1", "text": "password", "ts": "3.0"}`),
+		parseTestEvent(t, `{"type": "message", "subtype": "message_deleted", "channel": "C1", "ts": "4.0"}`),
+		parseTestEvent(t, `{"type": "app_mention", "channel": "C1", "user": "U1", "text": "password"}`),
+	}
+	for _, e := range ignored {
+		if violations := moderator.Handle(context.Background(), e); len(violations) != 0 {
+			t.Errorf("Expected %s to be ignored, got %#v", e.InnerEvent.Type, violations)
+		}
+	}
+
+	edited := parseTestEvent(t, `{"type": "message", "subtype": "message_changed", "channel": "C1", "ts": "5.0",
+		"message": {"type": "message", "user": "U1", "text": "new password: hunter3", "ts": "1.5"}}`)
+	moderator.Handler(context.Background())(edited)
+	if len(deleted) != 2 || deleted[1] != "xoxp-admin C1 1.5" {
+		t.Errorf("Expected the edited message to be deleted, got %v", deleted)
+	}
+}
+
+// postedMessage is a message posted during the test.
+type postedMessage struct {
+	channel  string
+	threadTS string
+	text     string
+}
+
+func TestNotifyChannelActionSanitizesQuote(t *testing.T) {
+	var posted []string
+	server := httptest.NewServer(http.HandlerFunc(func(rw http.ResponseWriter, r *http.Request) {
+		posted = append(posted, r.FormValue("text"))
+		rw.Header().Set("Content-Type", "application/json")
+		rw.Write([]byte(`{"ok": true, "channel": "CMOD", "ts": "2.0"}`))
+	}))
+	defer server.Close()
+
+	notify := NotifyChannelAction(slack.New("xoxb-bot", slack.OptionAPIURL(server.URL+"/")), "CMOD")
+	violation := Violation{
+		Rule:    ModerationRule{Name: "secrets"},
+		Message: &MessageEvent{Channel: "C1", User: "U1", Text: "<!channel> the password\n<@U2> is *hunter2*"},
+	}
+	if err := notify(context.Background(), violation); err != nil {
+		t.Fatal(err)
+	}
+
+	expected := "Message from <@U1> in <#C1> matched rule *secrets*:\n>&lt;!channel&gt; the password\n>&lt;@\u200bU2&gt; is *\u200bhunter2*\u200b"
+	if len(posted) != 1 || posted[0] != expected {
+		t.Errorf("Unexpected notification: %q", posted)
+	}
+}
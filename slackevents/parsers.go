@@ -87,7 +87,7 @@ func parseOuterEvent(rawE json.RawMessage) (EventsAPIEvent, error) {
 	}, nil
 }
 
-func parseInnerEvent(e *EventsAPICallbackEvent) (EventsAPIEvent, error) {
+func parseInnerEvent(e *EventsAPICallbackEvent, strict bool) (EventsAPIEvent, error) {
 	iE := &slack.Event{}
 	rawInnerJSON := e.InnerEvent
 	err := json.Unmarshal(*rawInnerJSON, iE)
@@ -103,6 +103,10 @@ func parseInnerEvent(e *EventsAPICallbackEvent) (EventsAPIEvent, error) {
 	}
 	v, exists := eventsMap(iE.Type)
 	if !exists {
+		err = fmt.Errorf("Inner Event does not exist! %s", iE.Type)
+		if strict {
+			err = &slack.UnknownTypeError{Kind: "event", Type: iE.Type, JSON: *rawInnerJSON}
+		}
 		return EventsAPIEvent{
 			e.Token,
 			e.TeamID,
@@ -110,11 +114,15 @@ func parseInnerEvent(e *EventsAPICallbackEvent) (EventsAPIEvent, error) {
 			e.APIAppID,
 			nil,
 			EventsAPIInnerEvent{},
-		}, fmt.Errorf("Inner Event does not exist! %s", iE.Type)
+		}, err
 	}
 	t := reflect.TypeOf(v)
 	recvEvent := reflect.New(t).Interface()
-	err = json.Unmarshal(*rawInnerJSON, recvEvent)
+	if strict {
+		err = slack.UnmarshalStrict(*rawInnerJSON, recvEvent)
+	} else {
+		err = json.Unmarshal(*rawInnerJSON, recvEvent)
+	}
 	if err != nil {
 		return EventsAPIEvent{
 			e.Token,
@@ -138,6 +146,8 @@ func parseInnerEvent(e *EventsAPICallbackEvent) (EventsAPIEvent, error) {
 type Config struct {
 	VerificationToken string
 	TokenVerified     bool
+	// Strict reports unknown types with a *slack.UnknownTypeError, see OptionStrictDecoding.
+	Strict bool
 }
 
 type Option func(cfg *Config)
@@ -159,6 +169,15 @@ func OptionNoVerifyToken() Option {
 	}
 }
 
+// OptionStrictDecoding makes ParseEvent return a *slack.UnknownTypeError when the inner event type
+// is unknown, or when the inner event contains an unknown block or block element type, see
+// slack.UnmarshalStrict. Only the events API is covered, not the RTM or socket mode events.
+func OptionStrictDecoding() Option {
+	return func(cfg *Config) {
+		cfg.Strict = true
+	}
+}
+
 type TokenComparator struct {
 	VerificationToken string
 }
@@ -188,9 +207,12 @@ func ParseEvent(rawEvent json.RawMessage, opts ...Option) (EventsAPIEvent, error
 
 	if e.Type == CallbackEvent {
 		cbEvent := e.Data.(*EventsAPICallbackEvent)
-		innerEvent, err := parseInnerEvent(cbEvent)
+		innerEvent, err := parseInnerEvent(cbEvent, cfg.Strict)
 		if err != nil {
-			err := fmt.Errorf("EventsAPI Error parsing inner event: %s, %s", innerEvent.Type, err)
+			// in strict mode unknown types are returned as they are, so callers can inspect them.
+			if _, unknown := err.(*slack.UnknownTypeError); !cfg.Strict || !unknown {
+				err = fmt.Errorf("EventsAPI Error parsing inner event: %s, %s", innerEvent.Type, err)
+			}
 			return EventsAPIEvent{
 				"",
 				"",
@@ -143,3 +143,22 @@ func TestNoTokenVerification(t *testing.T) {
 		t.Fail()
 	}
 }
+
+func TestStrictDecoding(t *testing.T) {
+	unknown := `{"token": "XXYYZZ", "team_id": "T1", "type": "event_callback", "event": {"type": "future_event", "user": "U1"}}`
+	if _, err := ParseEvent(json.RawMessage(unknown), OptionNoVerifyToken(), OptionStrictDecoding()); err == nil {
+		t.Error("expected an error")
+	} else if e, ok := err.(*slack.UnknownTypeError); !ok || e.Kind != "event" || e.Type != "future_event" || string(e.JSON) != `{"type": "future_event", "user": "U1"}` {
+		t.Errorf("unexpected error %#v", err)
+	}
+	if _, err := ParseEvent(json.RawMessage(unknown), OptionNoVerifyToken()); err == nil {
+		t.Error("expected an error without strict decoding")
+	} else if _, ok := err.(*slack.UnknownTypeError); ok {
+		t.Errorf("expected a generic error without strict decoding, got %#v", err)
+	}
+
+	known := `{"token": "XXYYZZ", "team_id": "T1", "type": "event_callback", "event": {"type": "message", "channel": "C1", "text": "hi"}}`
+	if _, err := ParseEvent(json.RawMessage(known), OptionNoVerifyToken(), OptionStrictDecoding()); err != nil {
+		t.Errorf("unexpected error: %s", err)
+	}
+}
@@ -0,0 +1,88 @@
+package slackutilsx
+
+import (
+	"strings"
+	"unicode"
+	"unicode/utf8"
+
+	"github.com/slack-go/slack/internal/errorsx"
+)
+
+// ChannelNameMaxLength is the maximum number of characters in a channel name.
+const ChannelNameMaxLength = 80
+
+// Errors returned by ValidateChannelName.
+const (
+	ErrChannelNameEmpty     errorsx.String = "channel name is empty"
+	ErrChannelNameTooLong   errorsx.String = "channel name is longer than 80 characters"
+	ErrChannelNameUppercase errorsx.String = "channel name contains uppercase letters"
+	ErrChannelNameInvalid   errorsx.String = "channel name contains characters other than letters, numbers, hyphens and underscores"
+)
+
+// ValidateChannelName checks the name against the constraints conversations.create applies:
+// lowercase letters, numbers, hyphens and underscores only, at most 80 characters.
+func ValidateChannelName(name string) error {
+	if name == "" {
+		return ErrChannelNameEmpty
+	}
+
+	if utf8.RuneCountInString(name) > ChannelNameMaxLength {
+		return ErrChannelNameTooLong
+	}
+
+	for _, r := range name {
+		switch {
+		case unicode.IsUpper(r):
+			return ErrChannelNameUppercase
+		case !validChannelNameRune(r):
+			return ErrChannelNameInvalid
+		}
+	}
+
+	return nil
+}
+
+// NormalizeChannelName converts name into a channel name accepted by conversations.create:
+// letters are lowercased, whitespace and periods become hyphens, other invalid characters are
+// dropped, repeated hyphens are collapsed and the result is truncated to 80 characters.
+// An empty string is returned when nothing usable is left.
+func NormalizeChannelName(name string) string {
+	var (
+		b      strings.Builder
+		n      int
+		hyphen bool
+	)
+
+	for _, r := range strings.TrimSpace(name) {
+		if n >= ChannelNameMaxLength {
+			break
+		}
+
+		r = unicode.ToLower(r)
+		if unicode.IsSpace(r) || r == '.' {
+			r = '-'
+		}
+
+		if !validChannelNameRune(r) {
+			continue
+		}
+
+		if r == '-' {
+			if hyphen {
+				continue
+			}
+			hyphen = true
+		} else {
+			hyphen = false
+		}
+
+		b.WriteRune(r)
+		n++
+	}
+
+	return strings.Trim(b.String(), "-")
+}
+
+func validChannelNameRune(r rune) bool {
+	return r == '-' || r == '_' || unicode.IsLetter(r) || unicode.IsNumber(r) || unicode.Is(unicode.Mn, r)
+}
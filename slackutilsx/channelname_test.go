@@ -0,0 +1,49 @@
+package slackutilsx
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestValidateChannelName(t *testing.T) {
+	for name, expected := range map[string]error{
+		"team-alpha":            nil,
+		"team_alpha-2":          nil,
+		"équipe-café":           nil,
+		"チーム":                   nil,
+		"":                      ErrChannelNameEmpty,
+		strings.Repeat("a", 81): ErrChannelNameTooLong,
+		strings.Repeat("é", 80): nil,
+		"Team-Alpha":            ErrChannelNameUppercase,
+		"team alpha":            ErrChannelNameInvalid,
+		"team.alpha":            ErrChannelNameInvalid,
+		"team#alpha":            ErrChannelNameInvalid,
+	} {
+		if err := ValidateChannelName(name); err != expected {
+			t.Errorf("%q: expected %v, got %v", name, expected, err)
+		}
+	}
+}
+
+func TestNormalizeChannelName(t *testing.T) {
+	for name, expected := range map[string]string{
+		"team-alpha":             "team-alpha",
+		"Team Alpha":             "team-alpha",
+		"  Q3 . Planning  ":      "q3-planning",
+		"release v1.2.3":         "release-v1-2-3",
+		"#general!":              "general",
+		"Équipe Café":            "équipe-café",
+		"---":                    "",
+		strings.Repeat("ab", 50): strings.Repeat("ab", 40),
+	} {
+		got := NormalizeChannelName(name)
+		if got != expected {
+			t.Errorf("%q: expected %q, got %q", name, expected, got)
+		}
+		if got != "" {
+			if err := ValidateChannelName(got); err != nil {
+				t.Errorf("%q: normalized name %q is invalid: %v", name, got, err)
+			}
+		}
+	}
+}
@@ -0,0 +1,200 @@
+package slackutilsx
+
+import (
+	"strings"
+	"unicode"
+)
+
+// ArgType the type of a command argument.
+type ArgType int
+
+func (t ArgType) String() string {
+	switch t {
+	case ArgTypeText:
+		return "Text"
+	case ArgTypeUser:
+		return "User"
+	case ArgTypeChannel:
+		return "Channel"
+	case ArgTypeEmoji:
+		return "Emoji"
+	case ArgTypeLink:
+		return "Link"
+	default:
+		return "Unknown"
+	}
+}
+
+const (
+	// ArgTypeText is a plain word or quoted string.
+	ArgTypeText ArgType = iota
+	// ArgTypeUser is a user mention, e.g. <@U1234|bob>.
+	ArgTypeUser
+	// ArgTypeChannel is a channel reference, e.g. <#C1234|general>.
+	ArgTypeChannel
+	// ArgTypeEmoji is an emoji code, e.g. :tada:.
+	ArgTypeEmoji
+	// ArgTypeLink is a link, e.g. <https://example.com|example>.
+	ArgTypeLink
+)
+
+// Arg a single argument of a command.
+type Arg struct {
+	Type ArgType
+	// Raw is the token as it appeared in the text.
+	Raw string
+	// Value is the ID for mentions and channels, the name for emoji,
+	// the URL for links and the unescaped text otherwise.
+	Value string
+	// Label is the optional human readable part of a mention, channel or link.
+	Label string
+}
+
+// Command is the result of parsing the text of an app mention or slash command.
+type Command struct {
+	// Mention is the user ID of the leading mention, usually the bot, if there was one.
+	Mention string
+	Args    []Arg
+}
+
+// Strings returns the values of the arguments, argv style.
+func (t Command) Strings() []string {
+	values := make([]string, 0, len(t.Args))
+	for _, arg := range t.Args {
+		values = append(values, arg.Value)
+	}
+	return values
+}
+
+// ParseCommand splits the text of an app mention or slash command into arguments.
+// Quoted strings (single, double and the curly quotes slack clients insert) are kept
+// together, a leading user mention is removed and stored in Command.Mention, and
+// mentions, channel references, emoji and links are returned as typed arguments.
+func ParseCommand(text string) Command {
+	var (
+		cmd    Command
+		tokens = tokenize(text)
+	)
+
+	if len(tokens) > 0 && !tokens[0].quoted {
+		if arg := parseArg(tokens[0].value); arg.Type == ArgTypeUser {
+			cmd.Mention = arg.Value
+			tokens = tokens[1:]
+		}
+	}
+
+	for _, tok := range tokens {
+		if tok.quoted {
+			cmd.Args = append(cmd.Args, Arg{Type: ArgTypeText, Raw: tok.value, Value: unescapeMessage(tok.value)})
+			continue
+		}
+		cmd.Args = append(cmd.Args, parseArg(tok.value))
+	}
+
+	return cmd
+}
+
+type token struct {
+	value  string
+	quoted bool
+}
+
+func closingQuote(r rune) (rune, bool) {
+	switch r {
+	case '"', '\'':
+		return r, true
+	case '“':
+		return '”', true
+	case '‘':
+		return '’', true
+	default:
+		return 0, false
+	}
+}
+
+func tokenize(text string) (tokens []token) {
+	var (
+		current  strings.Builder
+		inToken  bool
+		quote    rune
+		inQuote  bool
+		inAngles bool
+	)
+
+	flush := func(quoted bool) {
+		if inToken || quoted {
+			tokens = append(tokens, token{value: current.String(), quoted: quoted})
+		}
+		current.Reset()
+		inToken = false
+	}
+
+	for _, r := range text {
+		switch {
+		case inQuote:
+			if r == quote {
+				flush(true)
+				inQuote = false
+				continue
+			}
+			current.WriteRune(r)
+		case inAngles:
+			current.WriteRune(r)
+			if r == '>' {
+				inAngles = false
+			}
+		case unicode.IsSpace(r):
+			flush(false)
+		default:
+			if q, ok := closingQuote(r); ok && !inToken {
+				quote, inQuote = q, true
+				continue
+			}
+			if r == '<' {
+				inAngles = true
+			}
+			current.WriteRune(r)
+			inToken = true
+		}
+	}
+
+	// an unterminated quote consumes the remainder of the text.
+	flush(inQuote)
+
+	return tokens
+}
+
+func parseArg(raw string) Arg {
+	arg := Arg{Type: ArgTypeText, Raw: raw, Value: unescapeMessage(raw)}
+
+	switch {
+	case strings.HasPrefix(raw, "<") && strings.HasSuffix(raw, ">") && len(raw) > 2:
+		inner := raw[1 : len(raw)-1]
+		value, label := inner, ""
+		if i := strings.IndexByte(inner, '|'); i >= 0 {
+			value, label = inner[:i], inner[i+1:]
+		}
+
+		switch {
+		case strings.HasPrefix(value, "@"):
+			arg.Type, arg.Value = ArgTypeUser, value[1:]
+		case strings.HasPrefix(value, "#"):
+			arg.Type, arg.Value = ArgTypeChannel, value[1:]
+		case strings.HasPrefix(value, "!"):
+			// special mentions (here, channel, subteam) are left as text.
+			return arg
+		default:
+			arg.Type, arg.Value = ArgTypeLink, unescapeMessage(value)
+		}
+		arg.Label = unescapeMessage(label)
+	case len(raw) > 2 && strings.HasPrefix(raw, ":") && strings.HasSuffix(raw, ":") && !strings.ContainsAny(raw[1:len(raw)-1], ": "):
+		arg.Type, arg.Value = ArgTypeEmoji, raw[1:len(raw)-1]
+	}
+
+	return arg
+}
+
+func unescapeMessage(message string) string {
+	replacer := strings.NewReplacer("&amp;", "&", "&lt;", "<", "&gt;", ">")
+	return replacer.Replace(message)
+}
@@ -0,0 +1,46 @@
+package slackutilsx
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestParseCommand(t *testing.T) {
+	cmd := ParseCommand(`<@UBOT> deploy "my app" <#C1234|prod> to <@U5678> :rocket: <https://example.com?a=1&amp;b=2|docs> &lt;x&gt;`)
+
+	if cmd.Mention != "UBOT" {
+		t.Errorf("expected mention UBOT, got: %s", cmd.Mention)
+	}
+
+	expected := []Arg{
+		{Type: ArgTypeText, Raw: "deploy", Value: "deploy"},
+		{Type: ArgTypeText, Raw: "my app", Value: "my app"},
+		{Type: ArgTypeChannel, Raw: "<#C1234|prod>", Value: "C1234", Label: "prod"},
+		{Type: ArgTypeText, Raw: "to", Value: "to"},
+		{Type: ArgTypeUser, Raw: "<@U5678>", Value: "U5678"},
+		{Type: ArgTypeEmoji, Raw: ":rocket:", Value: "rocket"},
+		{Type: ArgTypeLink, Raw: "<https://example.com?a=1&amp;b=2|docs>", Value: "https://example.com?a=1&b=2", Label: "docs"},
+		{Type: ArgTypeText, Raw: "&lt;x&gt;", Value: "<x>"},
+	}
+	if !reflect.DeepEqual(expected, cmd.Args) {
+		t.Errorf("expected args %#v, got: %#v", expected, cmd.Args)
+	}
+}
+
+func TestParseCommandQuotes(t *testing.T) {
+	test := func(text string, expected ...string) {
+		if computed := ParseCommand(text).Strings(); !reflect.DeepEqual(expected, computed) {
+			t.Errorf("expected %q to parse to %q, got: %q", text, expected, computed)
+		}
+	}
+
+	test(`remind 'stand up' “in 5 minutes”`, "remind", "stand up", "in 5 minutes")
+	test(`don't  panic`, "don't", "panic")
+	test(`say ""`, "say", "")
+	test(`say "unterminated quote`, "say", "unterminated quote")
+	test(`<!here> hello`, "<!here>", "hello")
+
+	if args := ParseCommand("   ").Args; len(args) != 0 {
+		t.Errorf("expected no args, got: %#v", args)
+	}
+}
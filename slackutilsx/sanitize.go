@@ -0,0 +1,49 @@
+package slackutilsx
+
+import (
+	"strings"
+	"unicode"
+)
+
+// zeroWidthSpace is inserted to break formatting without changing how text is displayed.
+const zeroWidthSpace = "\u200b"
+
+var (
+	mrkdwnReplacer = strings.NewReplacer(
+		"&", "&amp;",
+		"<", "&lt;",
+		">", "&gt;",
+		"*", "*"+zeroWidthSpace,
+		"_", "_"+zeroWidthSpace,
+		"~", "~"+zeroWidthSpace,
+		"`", "`"+zeroWidthSpace,
+		"@", "@"+zeroWidthSpace,
+	)
+)
+
+// SanitizePlainText removes the control characters, other than newlines and tabs, from untrusted text.
+func SanitizePlainText(text string) string {
+	return strings.Map(func(r rune) rune {
+		if r == '\n' || r == '\t' {
+			return r
+		}
+		if unicode.IsControl(r) || r == unicode.ReplacementChar {
+			return -1
+		}
+		return r
+	}, text)
+}
+
+// SanitizeMrkdwn makes untrusted text safe to interpolate in mrkdwn: control characters are
+// removed, &, < and > are escaped so mentions such as <!channel> or links can't be injected,
+// and formatting characters, block quotes and @mentions are broken with a zero width space
+// so the text is displayed as written.
+func SanitizeMrkdwn(text string) string {
+	lines := strings.Split(mrkdwnReplacer.Replace(SanitizePlainText(text)), "\n")
+	for i, line := range lines {
+		if strings.HasPrefix(line, "&gt;") {
+			lines[i] = zeroWidthSpace + line
+		}
+	}
+	return strings.Join(lines, "\n")
+}
@@ -0,0 +1,27 @@
+package slackutilsx
+
+import "testing"
+
+func TestSanitizePlainText(t *testing.T) {
+	if got := SanitizePlainText("line\x00 one\r\n\tline\x1b[31m two"); got != "line one\n\tline[31m two" {
+		t.Errorf("unexpected result: %q", got)
+	}
+}
+
+func TestSanitizeMrkdwn(t *testing.T) {
+	for _, tt := range []struct {
+		input string
+		want  string
+	}{
+		{"hello world", "hello world"},
+		{"<!channel> <@U123> <https://evil.example|click>", "&lt;!channel&gt; &lt;@\u200bU123&gt; &lt;https://evil.example|click&gt;"},
+		{"@here look", "@\u200bhere look"},
+		{"*bold* _italic_ ~strike~ `code`", "*\u200bbold*\u200b _\u200bitalic_\u200b ~\u200bstrike~\u200b `\u200bcode`\u200b"},
+		{"> quoted\nnot > quoted", "\u200b&gt; quoted\nnot &gt; quoted"},
+		{"a & b\x07", "a &amp; b"},
+	} {
+		if got := SanitizeMrkdwn(tt.input); got != tt.want {
+			t.Errorf("%q: expected %q, got %q", tt.input, tt.want, got)
+		}
+	}
+}
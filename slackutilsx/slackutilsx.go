@@ -50,6 +50,127 @@ func DetectChannelType(channelID string) ChannelType {
 	}
 }
 
+// IDType the type of object an identifier refers to, based on its prefix.
+type IDType int
+
+func (t IDType) String() string {
+	switch t {
+	case IDTypeChannel:
+		return "Channel"
+	case IDTypeGroup:
+		return "Group"
+	case IDTypeDM:
+		return "Direct"
+	case IDTypeUser:
+		return "User"
+	case IDTypeEnterpriseUser:
+		return "EnterpriseUser"
+	case IDTypeBot:
+		return "Bot"
+	case IDTypeTeam:
+		return "Team"
+	default:
+		return "Unknown"
+	}
+}
+
+const (
+	// IDTypeUnknown represents identifiers we cannot properly detect.
+	IDTypeUnknown IDType = iota
+	// IDTypeChannel is a public channel (C...).
+	IDTypeChannel
+	// IDTypeGroup is a private channel or multi-party direct message (G...).
+	IDTypeGroup
+	// IDTypeDM is a direct message between two slack users (D...).
+	IDTypeDM
+	// IDTypeUser is a workspace user (U...).
+	IDTypeUser
+	// IDTypeEnterpriseUser is an enterprise grid user (W...).
+	IDTypeEnterpriseUser
+	// IDTypeBot is a bot (B...).
+	IDTypeBot
+	// IDTypeTeam is a workspace (T...).
+	IDTypeTeam
+)
+
+// DetectIDType converts an identifier to an IDType.
+// Anything that doesn't look like a slack identifier is reported as IDTypeUnknown.
+func DetectIDType(id string) IDType {
+	if !isID(id) {
+		return IDTypeUnknown
+	}
+
+	switch id[0] {
+	case 'C':
+		return IDTypeChannel
+	case 'G':
+		return IDTypeGroup
+	case 'D':
+		return IDTypeDM
+	case 'U':
+		return IDTypeUser
+	case 'W':
+		return IDTypeEnterpriseUser
+	case 'B':
+		return IDTypeBot
+	case 'T':
+		return IDTypeTeam
+	default:
+		return IDTypeUnknown
+	}
+}
+
+// IsConversationID returns true if the id refers to a channel, group or direct message.
+func IsConversationID(id string) bool {
+	switch DetectIDType(id) {
+	case IDTypeChannel, IDTypeGroup, IDTypeDM:
+		return true
+	default:
+		return false
+	}
+}
+
+// ParseChannelReference splits a channel reference into an ID and a name.
+// It understands escaped channel links ("<#C1234|general>"), names ("#general"
+// or "general") and raw IDs ("C1234"). Either return value may be empty
+// depending on what the reference contained.
+func ParseChannelReference(ref string) (id, name string) {
+	ref = strings.TrimSpace(ref)
+
+	if strings.HasPrefix(ref, "<#") && strings.HasSuffix(ref, ">") {
+		ref = ref[2 : len(ref)-1]
+		if i := strings.IndexByte(ref, '|'); i >= 0 {
+			return ref[:i], ref[i+1:]
+		}
+		return ref, ""
+	}
+
+	if strings.HasPrefix(ref, "#") {
+		return "", ref[1:]
+	}
+
+	if IsConversationID(ref) {
+		return ref, ""
+	}
+
+	return "", ref
+}
+
+// isID checks that s only contains the characters slack uses for identifiers.
+func isID(s string) bool {
+	if len(s) < 2 {
+		return false
+	}
+
+	for _, r := range s {
+		if (r < 'A' || r > 'Z') && (r < '0' || r > '9') {
+			return false
+		}
+	}
+
+	return true
+}
+
 // EscapeMessage text
 func EscapeMessage(message string) string {
 	replacer := strings.NewReplacer("&", "&amp;", "<", "&lt;", ">", "&gt;")
@@ -17,3 +17,39 @@ func TestDetectChannelType(t *testing.T) {
 	test("", CTypeUnknown)
 	test("X11111111", CTypeUnknown)
 }
+
+func TestDetectIDType(t *testing.T) {
+	test := func(id string, expected IDType) {
+		if computed := DetectIDType(id); computed != expected {
+			t.Errorf("expected id %s to have type %s, got: %s", id, expected, computed)
+		}
+	}
+
+	test("C11111111", IDTypeChannel)
+	test("G11111111", IDTypeGroup)
+	test("D11111111", IDTypeDM)
+	test("U11111111", IDTypeUser)
+	test("W11111111", IDTypeEnterpriseUser)
+	test("B11111111", IDTypeBot)
+	test("T11111111", IDTypeTeam)
+	test("", IDTypeUnknown)
+	test("X11111111", IDTypeUnknown)
+	test("general", IDTypeUnknown)
+}
+
+func TestParseChannelReference(t *testing.T) {
+	test := func(ref, expectedID, expectedName string) {
+		id, name := ParseChannelReference(ref)
+		if id != expectedID || name != expectedName {
+			t.Errorf("expected reference %q to parse to (%q, %q), got: (%q, %q)", ref, expectedID, expectedName, id, name)
+		}
+	}
+
+	test("<#C1234|general>", "C1234", "general")
+	test("<#C1234>", "C1234", "")
+	test("#general", "", "general")
+	test(" general ", "", "general")
+	test("C1234", "C1234", "")
+	test("G1234", "G1234", "")
+	test("Cgeneral", "", "Cgeneral")
+}
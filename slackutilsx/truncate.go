@@ -0,0 +1,107 @@
+package slackutilsx
+
+import (
+	"strings"
+	"unicode"
+	"unicode/utf8"
+)
+
+// Maximum lengths, in characters, of the text of block kit elements.
+const (
+	SectionTextLimit = 3000
+	HeaderTextLimit  = 150
+	ButtonTextLimit  = 75
+)
+
+// Ellipsis is appended to truncated text.
+const Ellipsis = "…"
+
+// entities are the escape sequences of mrkdwn, which must not be cut.
+var entities = []string{"&amp;", "&lt;", "&gt;"}
+
+// TruncateText shortens text to at most limit characters, ellipsis included, e.g.
+// TruncateText(title, HeaderTextLimit). Text is only cut between user perceived characters,
+// so combining marks, emoji modifiers and sequences, and flags are kept whole, and never in the
+// middle of a mrkdwn escape sequence such as &amp;. Text within the limit is returned unchanged.
+func TruncateText(text string, limit int) string {
+	if utf8.RuneCountInString(text) <= limit {
+		return text
+	}
+
+	ellipsisLen := utf8.RuneCountInString(Ellipsis)
+	if limit < ellipsisLen {
+		return ""
+	}
+
+	// find the end of the last character fitting before the ellipsis.
+	cut, count := 0, 0
+	for _, end := range graphemeBoundaries(text) {
+		if count+utf8.RuneCountInString(text[cut:end]) > limit-ellipsisLen {
+			break
+		}
+		count += utf8.RuneCountInString(text[cut:end])
+		cut = end
+	}
+
+	truncated := text[:cut]
+	if i := strings.LastIndexByte(truncated, '&'); i >= 0 {
+		for _, entity := range entities {
+			if strings.HasPrefix(text[i:], entity) && i+len(entity) > cut {
+				truncated = truncated[:i]
+				break
+			}
+		}
+	}
+
+	return strings.TrimRightFunc(truncated, unicode.IsSpace) + Ellipsis
+}
+
+// graphemeBoundaries returns the byte offsets ending each user perceived character of text.
+// It approximates the grapheme clusters of unicode: combining marks, variation selectors,
+// emoji modifiers, tags and characters joined by a zero width joiner extend the preceding
+// character, and regional indicators form pairs.
+func graphemeBoundaries(text string) []int {
+	var (
+		boundaries []int
+		prev       rune = -1
+		regional   int
+	)
+
+	for i, r := range text {
+		extends := prev != -1 && (isGraphemeExtender(r) || prev == '\u200d' || (prev == '\r' && r == '\n'))
+		if isRegionalIndicator(r) {
+			extends = extends || regional%2 == 1
+			regional++
+		} else {
+			regional = 0
+		}
+
+		if !extends && i > 0 {
+			boundaries = append(boundaries, i)
+		}
+		prev = r
+	}
+
+	if len(text) > 0 {
+		boundaries = append(boundaries, len(text))
+	}
+	return boundaries
+}
+
+func isGraphemeExtender(r rune) bool {
+	switch {
+	case unicode.In(r, unicode.Mn, unicode.Me, unicode.Mc):
+		return true
+	case r == '\u200d', r >= 0xfe00 && r <= 0xfe0f:
+		return true
+	case r >= 0x1f3fb && r <= 0x1f3ff: // emoji skin tone modifiers
+		return true
+	case r >= 0xe0020 && r <= 0xe007f: // tags, used by subdivision flags
+		return true
+	}
+	return false
+}
+
+func isRegionalIndicator(r rune) bool {
+	return r >= 0x1f1e6 && r <= 0x1f1ff
+}
@@ -0,0 +1,38 @@
+package slackutilsx
+
+import (
+	"testing"
+	"unicode/utf8"
+)
+
+func TestTruncateText(t *testing.T) {
+	for _, tt := range []struct {
+		text  string
+		limit int
+		want  string
+	}{
+		{"short", 10, "short"},
+		{"exactly ten", 11, "exactly ten"},
+		{"deploy finished", 10, "deploy fi…"},
+		{"deploy finished", 8, "deploy…"},
+		{"h\u00e9llo w\u00f6rld", 8, "h\u00e9llo w…"},
+		// a decomposed é is kept whole.
+		{"cafe\u0301 au lait", 6, "cafe\u0301…"},
+		{"cafe\u0301 au lait", 5, "caf…"},
+		{"ok \U0001f44d\U0001f3fd done", 6, "ok \U0001f44d\U0001f3fd…"},
+		{"ok \U0001f44d\U0001f3fd done", 5, "ok…"},
+		{"family \U0001f468\u200d\U0001f469\u200d\U0001f467 here", 10, "family…"},
+		{"flags \U0001f1eb\U0001f1f7\U0001f1e9\U0001f1ea here", 10, "flags \U0001f1eb\U0001f1f7…"},
+		{"fish &amp; chips", 8, "fish…"},
+		{"fish &amp; chips", 11, "fish &amp;…"},
+		{"anything", 0, ""},
+	} {
+		got := TruncateText(tt.text, tt.limit)
+		if got != tt.want {
+			t.Errorf("TruncateText(%q, %d) = %q, want %q", tt.text, tt.limit, got, tt.want)
+		}
+		if !utf8.ValidString(got) || utf8.RuneCountInString(got) > tt.limit {
+			t.Errorf("TruncateText(%q, %d) = %q exceeds the limit", tt.text, tt.limit, got)
+		}
+	}
+}
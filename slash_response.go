@@ -0,0 +1,133 @@
+package slack
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"net/http"
+	"sync"
+	"time"
+)
+
+// Limits of the response_url of a slash command.
+const (
+	ResponseURLLifetime     = 30 * time.Minute
+	ResponseURLMaxResponses = 5
+)
+
+// NewSlashResponse returns a response to a slash command, responseType is ResponseTypeEphemeral,
+// only shown to the user who invoked the command, or ResponseTypeInChannel.
+func NewSlashResponse(responseType, text string, blocks ...Block) *Msg {
+	return &Msg{
+		ResponseType: responseType,
+		Text:         text,
+		Blocks:       Blocks{BlockSet: blocks},
+	}
+}
+
+// NewEphemeralResponse returns a response only shown to the user who invoked the slash command.
+func NewEphemeralResponse(text string, blocks ...Block) *Msg {
+	return NewSlashResponse(ResponseTypeEphemeral, text, blocks...)
+}
+
+// NewInChannelResponse returns a response shown to the members of the channel, along with the command.
+func NewInChannelResponse(text string, blocks ...Block) *Msg {
+	return NewSlashResponse(ResponseTypeInChannel, text, blocks...)
+}
+
+// WriteSlashResponse writes the response as the body of the reply to the request of a slash
+// command, which slack expects within 3 seconds. It also answers legacy outgoing webhooks,
+// which post the text of the response to the channel.
+func WriteSlashResponse(w http.ResponseWriter, msg *Msg) error {
+	w.Header().Set("Content-Type", "application/json")
+	return json.NewEncoder(w).Encode(msg)
+}
+
+// SlashResponder posts delayed responses to the response_url of a slash command, e.g. once a
+// long running command completed. The url accepts ResponseURLMaxResponses responses during
+// ResponseURLLifetime, later responses fail with ErrResponseURLExpired or ErrResponseURLExhausted
+// without being sent. It's safe for concurrent use.
+type SlashResponder struct {
+	URL string
+	// ExpiresAt is when the url stops accepting responses.
+	ExpiresAt time.Time
+
+	httpClient *http.Client
+	clock      Clock
+
+	mu   sync.Mutex
+	sent int
+}
+
+// NewSlashResponder creates a responder for the command received at receivedAt, the http client
+// defaults to http.DefaultClient when nil.
+func NewSlashResponder(cmd SlashCommand, receivedAt time.Time, httpClient *http.Client) *SlashResponder {
+	return NewSlashResponderWithClock(cmd, receivedAt, httpClient, systemClock{})
+}
+
+// NewSlashResponderWithClock creates a responder checking the expiry of the url against the clock.
+func NewSlashResponderWithClock(cmd SlashCommand, receivedAt time.Time, httpClient *http.Client, clock Clock) *SlashResponder {
+	if httpClient == nil {
+		httpClient = http.DefaultClient
+	}
+
+	return &SlashResponder{
+		URL:        cmd.ResponseURL,
+		ExpiresAt:  receivedAt.Add(ResponseURLLifetime),
+		httpClient: httpClient,
+		clock:      clock,
+	}
+}
+
+// Remaining returns the number of responses the url still accepts, ignoring its expiry.
+func (r *SlashResponder) Remaining() int {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	return ResponseURLMaxResponses - r.sent
+}
+
+// Respond posts the response, set ReplaceOriginal or DeleteOriginal to change the previous response.
+func (r *SlashResponder) Respond(msg *Msg) error {
+	return r.RespondContext(context.Background(), msg)
+}
+
+// RespondContext posts the response with a custom context.
+func (r *SlashResponder) RespondContext(ctx context.Context, msg *Msg) error {
+	if err := r.reserve(); err != nil {
+		return err
+	}
+
+	raw, err := json.Marshal(msg)
+	if err != nil {
+		return err
+	}
+
+	req, err := http.NewRequest(http.MethodPost, r.URL, bytes.NewReader(raw))
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Content-Type", "application/json")
+
+	resp, err := r.httpClient.Do(req.WithContext(ctx))
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+
+	return checkStatusCode(resp, discard{})
+}
+
+// reserve counts a response, failing when the url no longer accepts them.
+func (r *SlashResponder) reserve() error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if !r.clock.Now().Before(r.ExpiresAt) {
+		return ErrResponseURLExpired
+	}
+	if r.sent >= ResponseURLMaxResponses {
+		return ErrResponseURLExhausted
+	}
+	r.sent++
+	return nil
+}
@@ -0,0 +1,78 @@
+package slack
+
+import (
+	"encoding/json"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestWriteSlashResponse(t *testing.T) {
+	rec := httptest.NewRecorder()
+	msg := NewInChannelResponse("Deploying", NewDividerBlock())
+	if err := WriteSlashResponse(rec, msg); err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	assert.Equal(t, "application/json", rec.Header().Get("Content-Type"))
+	assert.JSONEq(t, `{"response_type":"in_channel","text":"Deploying","replace_original":false,"delete_original":false,"blocks":[{"type":"divider"}]}`, rec.Body.String())
+}
+
+func TestSlashResponder(t *testing.T) {
+	var bodies []map[string]interface{}
+	server := httptest.NewServer(http.HandlerFunc(func(rw http.ResponseWriter, r *http.Request) {
+		raw, _ := ioutil.ReadAll(r.Body)
+		var body map[string]interface{}
+		if err := json.Unmarshal(raw, &body); err != nil {
+			t.Errorf("unexpected error: %s", err)
+		}
+		bodies = append(bodies, body)
+		rw.Write([]byte("ok"))
+	}))
+	defer server.Close()
+
+	cmd := SlashCommand{Command: "/deploy", ResponseURL: server.URL + "/commands/T1/1/abc"}
+	responder := NewSlashResponder(cmd, time.Now(), nil)
+
+	if err := responder.Respond(NewEphemeralResponse("Deploying…")); err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	done := NewInChannelResponse("Deployed")
+	done.ReplaceOriginal = true
+	if err := responder.Respond(done); err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	if len(bodies) != 2 || bodies[0]["response_type"] != "ephemeral" || bodies[1]["text"] != "Deployed" || bodies[1]["replace_original"] != true {
+		t.Errorf("unexpected responses %v", bodies)
+	}
+
+	for i := 0; i < 3; i++ {
+		if err := responder.Respond(NewEphemeralResponse("again")); err != nil {
+			t.Fatalf("unexpected error: %s", err)
+		}
+	}
+	if responder.Remaining() != 0 {
+		t.Errorf("expected no remaining responses, got %d", responder.Remaining())
+	}
+	if err := responder.Respond(NewEphemeralResponse("too many")); err != ErrResponseURLExhausted {
+		t.Errorf("expected ErrResponseURLExhausted, got %v", err)
+	}
+
+	receivedAt := time.Unix(1591012800, 0)
+	clock := NewManualClock(receivedAt.Add(ResponseURLLifetime - time.Second))
+	expiring := NewSlashResponderWithClock(cmd, receivedAt, nil, clock)
+	if err := expiring.Respond(NewEphemeralResponse("just in time")); err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	clock.Advance(time.Second)
+	if err := expiring.Respond(NewEphemeralResponse("late")); err != ErrResponseURLExpired {
+		t.Errorf("expected ErrResponseURLExpired, got %v", err)
+	}
+	if len(bodies) != 6 {
+		t.Errorf("expected 6 responses to reach the server, got %d", len(bodies))
+	}
+}
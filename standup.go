@@ -0,0 +1,271 @@
+package slack
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"time"
+)
+
+const (
+	standupActionOpen = "open"
+	standupAnswerID   = "answer"
+
+	// slack rejects sections with more fields, and messages with more blocks.
+	standupMaxFields = 10
+	standupMaxBlocks = 50
+)
+
+// StandupConfig describes a recurring report collected from a set of users.
+type StandupConfig struct {
+	// CallbackID identifies the interactions belonging to this standup, it must be unique within the app.
+	CallbackID string
+	// Title is used for the form and the summary message.
+	Title string
+	// Prompt is the text of the direct message asking users to fill in the form.
+	Prompt    string
+	Questions []string
+	Users     []string
+	// SummaryChannel is where the compiled answers are posted.
+	SummaryChannel string
+}
+
+// Standup asks users to fill in a form and posts a summary of the answers to a channel.
+// Users are sent a direct message with a button which opens the form in a modal, the
+// resulting block_actions and view_submission interactions must be passed to HandleInteraction.
+type Standup struct {
+	client *Client
+	config StandupConfig
+
+	mu      sync.Mutex
+	answers map[string][]string
+}
+
+// NewStandup creates a standup using the provided configuration.
+func NewStandup(client *Client, config StandupConfig) *Standup {
+	return &Standup{
+		client:  client,
+		config:  config,
+		answers: map[string][]string{},
+	}
+}
+
+// Prompt sends the prompt to every user of the standup.
+func (s *Standup) Prompt(ctx context.Context) error {
+	for _, user := range s.config.Users {
+		if err := s.PromptUser(ctx, user); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// PromptUser sends a direct message to the user with a button that opens the form.
+func (s *Standup) PromptUser(ctx context.Context, userID string) error {
+	channel, _, _, err := s.client.OpenConversationContext(ctx, &OpenConversationParameters{Users: []string{userID}})
+	if err != nil {
+		return err
+	}
+
+	button := NewButtonBlockElement(standupActionOpen, s.config.CallbackID, NewTextBlockObject(PlainTextType, "Fill in", false, false))
+	_, _, err = s.client.PostMessageContext(
+		ctx,
+		channel.ID,
+		MsgOptionText(s.config.Prompt, false),
+		MsgOptionBlocks(
+			NewSectionBlock(NewTextBlockObject(MarkdownType, s.config.Prompt, false, false), nil, nil),
+			NewActionBlock(s.config.CallbackID, button),
+		),
+	)
+	return err
+}
+
+// Form returns the modal presented to users.
+func (s *Standup) Form() ModalViewRequest {
+	blocks := make([]Block, 0, len(s.config.Questions))
+	for i, question := range s.config.Questions {
+		input := NewPlainTextInputBlockElement(nil, standupAnswerID)
+		input.Multiline = true
+		blocks = append(blocks, NewInputBlock(standupQuestionID(i), NewTextBlockObject(PlainTextType, question, false, false), input))
+	}
+
+	return ModalViewRequest{
+		Type:       VTModal,
+		CallbackID: s.config.CallbackID,
+		Title:      NewTextBlockObject(PlainTextType, s.config.Title, false, false),
+		Submit:     NewTextBlockObject(PlainTextType, "Submit", false, false),
+		Close:      NewTextBlockObject(PlainTextType, "Cancel", false, false),
+		Blocks:     Blocks{BlockSet: blocks},
+	}
+}
+
+// HandleInteraction processes the interactions that belong to the standup, opening the form when
+// the prompt button is clicked and recording answers when the form is submitted.
+// It reports whether the callback belonged to this standup.
+func (s *Standup) HandleInteraction(ctx context.Context, callback *InteractionCallback) (bool, error) {
+	switch callback.Type {
+	case InteractionTypeBlockActions:
+		for _, action := range callback.ActionCallback.BlockActions {
+			if action.BlockID != s.config.CallbackID || action.ActionID != standupActionOpen {
+				continue
+			}
+			_, err := s.client.OpenViewContext(ctx, callback.TriggerID, s.Form())
+			return true, err
+		}
+	case InteractionTypeViewSubmission:
+		if callback.View.CallbackID != s.config.CallbackID {
+			return false, nil
+		}
+
+		answers := make([]string, len(s.config.Questions))
+		if callback.View.State != nil {
+			for i := range s.config.Questions {
+				answers[i] = callback.View.State.Values[standupQuestionID(i)][standupAnswerID].Value
+			}
+		}
+
+		s.mu.Lock()
+		s.answers[callback.User.ID] = answers
+		s.mu.Unlock()
+		return true, nil
+	}
+
+	return false, nil
+}
+
+// Answers returns the answers collected so far, keyed by user ID.
+func (s *Standup) Answers() map[string][]string {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	answers := make(map[string][]string, len(s.answers))
+	for user, a := range s.answers {
+		answers[user] = a
+	}
+	return answers
+}
+
+// Summary builds the blocks summarising the collected answers, the title and the answers are
+// sanitized so they can't mention anyone. There may be more blocks than a message accepts,
+// PostSummary splits them in several messages.
+func (s *Standup) Summary() []Block {
+	answers := s.Answers()
+	blocks := []Block{
+		NewSectionBlock(NewSanitizedTextBlockObject(MarkdownType, "*%s*", s.config.Title), nil, nil),
+	}
+
+	var missing string
+	for _, user := range s.config.Users {
+		a, ok := answers[user]
+		if !ok {
+			missing += fmt.Sprintf(" <@%s>", user)
+			continue
+		}
+
+		fields := make([]*TextBlockObject, 0, len(a))
+		for i, answer := range a {
+			fields = append(fields, NewSanitizedTextBlockObject(MarkdownType, "*%s*\n%s", s.config.Questions[i], answer))
+		}
+		blocks = append(blocks, NewDividerBlock())
+		// the answers continue in sections without text past the fields limit.
+		text := NewTextBlockObject(MarkdownType, fmt.Sprintf("<@%s>", user), false, false)
+		for len(fields) > standupMaxFields {
+			blocks = append(blocks, NewSectionBlock(text, fields[:standupMaxFields], nil))
+			fields, text = fields[standupMaxFields:], nil
+		}
+		blocks = append(blocks, NewSectionBlock(text, fields, nil))
+	}
+
+	if missing != "" {
+		blocks = append(blocks, NewContextBlock("", NewTextBlockObject(MarkdownType, "No answer from"+missing, false, false)))
+	}
+
+	return blocks
+}
+
+// PostSummary posts the summary to the summary channel and clears the collected answers. The
+// summary is split in several messages when it has more blocks than a message accepts.
+func (s *Standup) PostSummary(ctx context.Context) error {
+	for _, blocks := range standupMessages(s.Summary()) {
+		_, _, err := s.client.PostMessageContext(
+			ctx,
+			s.config.SummaryChannel,
+			MsgOptionText(s.config.Title, false),
+			MsgOptionBlocks(blocks...),
+		)
+		if err != nil {
+			return err
+		}
+	}
+
+	s.mu.Lock()
+	s.answers = map[string][]string{}
+	s.mu.Unlock()
+
+	return nil
+}
+
+// Schedule prompts each user at the prompt recurrence in their own timezone and posts
+// the summary at the summary recurrence in loc, until ctx is cancelled.
+func (s *Standup) Schedule(ctx context.Context, scheduler *Scheduler, prompt Recurrence, summary Recurrence, loc *time.Location) error {
+	// the timezones are resolved first, so nothing is scheduled when one of them fails.
+	locations := make([]*time.Location, len(s.config.Users))
+	for i, user := range s.config.Users {
+		userLoc, err := scheduler.UserLocation(ctx, user)
+		if err != nil {
+			return err
+		}
+		locations[i] = userLoc
+	}
+
+	for i, user := range s.config.Users {
+		user := user
+		scheduler.Schedule(ctx, locations[i], prompt, func(ctx context.Context, _ time.Time) {
+			if err := s.PromptUser(ctx, user); err != nil {
+				s.client.Debugf("standup %s: failed to prompt %s: %v", s.config.CallbackID, user, err)
+			}
+		})
+	}
+
+	scheduler.Schedule(ctx, loc, summary, func(ctx context.Context, _ time.Time) {
+		if err := s.PostSummary(ctx); err != nil {
+			s.client.Debugf("standup %s: failed to post summary: %v", s.config.CallbackID, err)
+		}
+	})
+
+	return nil
+}
+
+// standupMessages splits the blocks of a summary in messages slack accepts, keeping the answers
+// of a user, which start with a divider, in the same message.
+func standupMessages(blocks []Block) [][]Block {
+	var messages [][]Block
+	var current []Block
+	for start := 0; start < len(blocks); {
+		end := start + 1
+		for end < len(blocks) && blocks[end].BlockType() != MBTDivider {
+			end++
+		}
+		group := blocks[start:end]
+		start = end
+
+		if len(current) > 0 && len(current)+len(group) > standupMaxBlocks {
+			messages = append(messages, current)
+			current = nil
+		}
+		for len(group) > standupMaxBlocks {
+			messages = append(messages, group[:standupMaxBlocks])
+			group = group[standupMaxBlocks:]
+		}
+		current = append(current, group...)
+	}
+	if len(current) > 0 {
+		messages = append(messages, current)
+	}
+	return messages
+}
+
+func standupQuestionID(i int) string {
+	return fmt.Sprintf("question-%d", i)
+}
@@ -0,0 +1,179 @@
+package slack
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestStandup(t *testing.T) {
+	http.DefaultServeMux = new(http.ServeMux)
+	defer func() { http.DefaultServeMux = new(http.ServeMux) }()
+
+	var (
+		opened ModalViewRequest
+		posted []string
+	)
+	http.HandleFunc("/conversations.open", func(rw http.ResponseWriter, r *http.Request) {
+		rw.Header().Set("Content-Type", "application/json")
+		rw.Write([]byte(`{"ok": true, "channel": {"id": "D` + r.FormValue("users") + `"}}`))
+	})
+	http.HandleFunc("/chat.postMessage", func(rw http.ResponseWriter, r *http.Request) {
+		posted = append(posted, r.FormValue("channel"))
+		rw.Header().Set("Content-Type", "application/json")
+		rw.Write([]byte(`{"ok": true, "channel": "` + r.FormValue("channel") + `", "ts": "1500000000.000000"}`))
+	})
+	http.HandleFunc("/views.open", func(rw http.ResponseWriter, r *http.Request) {
+		body, _ := ioutil.ReadAll(r.Body)
+		req := struct {
+			View *ModalViewRequest `json:"view"`
+		}{View: &opened}
+		json.Unmarshal(body, &req)
+		rw.Header().Set("Content-Type", "application/json")
+		rw.Write([]byte(`{"ok": true}`))
+	})
+
+	once.Do(startServer)
+	api := New("testing-token", OptionAPIURL("http://"+serverAddr+"/"))
+	standup := NewStandup(api, StandupConfig{
+		CallbackID:     "daily",
+		Title:          "Daily standup",
+		Prompt:         "Time for standup",
+		Questions:      []string{"Yesterday?", "Today?"},
+		Users:          []string{"U1", "U2"},
+		SummaryChannel: "C1",
+	})
+	ctx := context.Background()
+
+	assert.NoError(t, standup.Prompt(ctx))
+	assert.Equal(t, []string{"DU1", "DU2"}, posted)
+
+	handled, err := standup.HandleInteraction(ctx, &InteractionCallback{
+		Type:      InteractionTypeBlockActions,
+		TriggerID: "trigger",
+		ActionCallback: ActionCallbacks{
+			BlockActions: []*BlockAction{{BlockID: "daily", ActionID: "open"}},
+		},
+	})
+	assert.True(t, handled)
+	assert.NoError(t, err)
+	assert.Equal(t, "daily", opened.CallbackID)
+	assert.Len(t, opened.Blocks.BlockSet, 2)
+
+	handled, err = standup.HandleInteraction(ctx, &InteractionCallback{
+		Type: InteractionTypeViewSubmission,
+		User: User{ID: "U1"},
+		View: View{
+			CallbackID: "daily",
+			State: &ViewState{Values: map[string]map[string]BlockAction{
+				"question-0": {"answer": {Value: "coding"}},
+				"question-1": {"answer": {Value: "more coding"}},
+			}},
+		},
+	})
+	assert.True(t, handled)
+	assert.NoError(t, err)
+	assert.Equal(t, map[string][]string{"U1": {"coding", "more coding"}}, standup.Answers())
+
+	handled, _ = standup.HandleInteraction(ctx, &InteractionCallback{
+		Type: InteractionTypeViewSubmission,
+		View: View{CallbackID: "other"},
+	})
+	assert.False(t, handled)
+
+	// title, divider and answers for U1, context listing U2.
+	assert.Len(t, standup.Summary(), 4)
+
+	assert.NoError(t, standup.PostSummary(ctx))
+	assert.Equal(t, "C1", posted[len(posted)-1])
+	assert.Empty(t, standup.Answers())
+}
+
+func TestStandupSummaryLimits(t *testing.T) {
+	questions := make([]string, 12)
+	for i := range questions {
+		questions[i] = fmt.Sprintf("Question %d?", i)
+	}
+	users := make([]string, 30)
+	for i := range users {
+		users[i] = fmt.Sprintf("U%d", i)
+	}
+
+	standup := NewStandup(New("testing-token"), StandupConfig{
+		CallbackID: "daily",
+		Title:      "Daily <!here> standup",
+		Questions:  questions,
+		Users:      users,
+	})
+	for _, user := range users {
+		answers := make([]string, len(questions))
+		for i := range answers {
+			answers[i] = "<!channel> done"
+		}
+		standup.answers[user] = answers
+	}
+
+	summary := standup.Summary()
+	// title, then a divider and two sections per user.
+	assert.Len(t, summary, 1+30*3)
+	for _, block := range summary {
+		section, ok := block.(*SectionBlock)
+		if !ok {
+			continue
+		}
+		assert.True(t, len(section.Fields) <= 10, "too many fields: %d", len(section.Fields))
+		raw, err := json.Marshal(section)
+		assert.NoError(t, err)
+		assert.NotContains(t, string(raw), "<!")
+	}
+
+	messages := standupMessages(summary)
+	total := 0
+	for _, blocks := range messages {
+		assert.True(t, len(blocks) <= 50, "too many blocks: %d", len(blocks))
+		assert.Equal(t, MBTDivider, blocks[len(blocks)-3].BlockType())
+		total += len(blocks)
+	}
+	assert.Len(t, messages, 2)
+	assert.Equal(t, len(summary), total)
+}
+
+func TestStandupScheduleUnknownUser(t *testing.T) {
+	http.DefaultServeMux = new(http.ServeMux)
+	defer func() { http.DefaultServeMux = new(http.ServeMux) }()
+
+	http.HandleFunc("/users.info", func(rw http.ResponseWriter, r *http.Request) {
+		if r.FormValue("user") != "U1" {
+			rw.Header().Set("Content-Type", "application/json")
+			rw.Write([]byte(`{"ok": false, "error": "user_not_found"}`))
+			return
+		}
+		getUserInfo(rw, r)
+	})
+
+	once.Do(startServer)
+	api := New("testing-token", OptionAPIURL("http://"+serverAddr+"/"))
+	scheduler := NewScheduler(api)
+	standup := NewStandup(api, StandupConfig{CallbackID: "daily", Users: []string{"U1", "U2"}})
+
+	err := standup.Schedule(context.Background(), scheduler, Recurrence{Hour: 9}, Recurrence{Hour: 10}, time.UTC)
+	assert.EqualError(t, err, "user_not_found")
+
+	// nothing was scheduled, not even for the users before the unknown one.
+	done := make(chan struct{})
+	go func() {
+		scheduler.Wait()
+		close(done)
+	}()
+	select {
+	case <-done:
+	case <-time.After(time.Second):
+		t.Error("expected no job to be scheduled")
+	}
+}
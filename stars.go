@@ -4,7 +4,6 @@ import (
 	"context"
 	"net/url"
 	"strconv"
-	"time"
 )
 
 const (
@@ -193,7 +192,7 @@ func (api *Client) ListAllStarsContext(ctx context.Context) (results []Item, err
 			select {
 			case <-ctx.Done():
 				err = ctx.Err()
-			case <-time.After(rateLimitedError.RetryAfter):
+			case <-api.currentClock().After(rateLimitedError.RetryAfter):
 				err = nil
 			}
 		}
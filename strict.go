@@ -0,0 +1,99 @@
+package slack
+
+import (
+	"encoding/json"
+	"fmt"
+	"reflect"
+)
+
+// UnknownTypeError is returned by strict decoding when a payload contains a block or
+// block element type this library doesn't support. It's also returned by any decoding when
+// an input block, a context block or a list of block elements contains an element type this
+// library doesn't support, since there's no placeholder for those.
+type UnknownTypeError struct {
+	// Kind is "block", "block element", "context element", "rich text element" or, for the
+	// events parsed by slackevents, "event".
+	Kind string
+	Type string
+	// JSON is the offending object.
+	JSON json.RawMessage
+}
+
+func (t *UnknownTypeError) Error() string {
+	return fmt.Sprintf("unknown %s type %q: %s", t.Kind, t.Type, t.JSON)
+}
+
+// UnmarshalStrict decodes data into v like json.Unmarshal. Unlike json.Unmarshal, which decodes
+// unknown block and block element types into UnknownBlock and UnknownBlockElement, it returns
+// an *UnknownTypeError, so test suites notice when slack introduces new types.
+func UnmarshalStrict(data []byte, v interface{}) error {
+	if err := json.Unmarshal(data, v); err != nil {
+		return err
+	}
+
+	return checkUnknownTypes(reflect.ValueOf(v))
+}
+
+// OptionStrictDecoding makes the client return an *UnknownTypeError when a response contains a
+// block or block element type this library doesn't support, see UnmarshalStrict.
+func OptionStrictDecoding(b bool) func(*Client) {
+	return func(c *Client) {
+		c.strict = b
+	}
+}
+
+// checkResponse applies the decoding mode of the client to a decoded response.
+func (api *Client) checkResponse(intf interface{}) error {
+	if !api.strict {
+		return nil
+	}
+
+	return checkUnknownTypes(reflect.ValueOf(intf))
+}
+
+var (
+	unknownBlockType        = reflect.TypeOf(UnknownBlock{})
+	unknownBlockElementType = reflect.TypeOf(UnknownBlockElement{})
+	unknownRichTextType     = reflect.TypeOf(RichTextUnknown{})
+	unknownRichTextSection  = reflect.TypeOf(RichTextSectionUnknownElement{})
+)
+
+// checkUnknownTypes walks v looking for the placeholders of unknown types.
+func checkUnknownTypes(v reflect.Value) error {
+	switch v.Kind() {
+	case reflect.Ptr, reflect.Interface:
+		if v.IsNil() {
+			return nil
+		}
+		return checkUnknownTypes(v.Elem())
+	case reflect.Struct:
+		switch v.Type() {
+		case unknownBlockType:
+			return &UnknownTypeError{Kind: "block", Type: v.FieldByName("Type").String(), JSON: v.FieldByName("raw").Bytes()}
+		case unknownBlockElementType:
+			return &UnknownTypeError{Kind: "block element", Type: v.FieldByName("Type").String(), JSON: v.FieldByName("raw").Bytes()}
+		case unknownRichTextType, unknownRichTextSection:
+			return &UnknownTypeError{Kind: "rich text element", Type: v.FieldByName("Type").String(), JSON: v.FieldByName("Raw").Bytes()}
+		}
+		for i := 0; i < v.NumField(); i++ {
+			if err := checkUnknownTypes(v.Field(i)); err != nil {
+				return err
+			}
+		}
+	case reflect.Slice, reflect.Array:
+		for i := 0; i < v.Len(); i++ {
+			if err := checkUnknownTypes(v.Index(i)); err != nil {
+				return err
+			}
+		}
+	case reflect.Map:
+		iter := v.MapRange()
+		for iter.Next() {
+			if err := checkUnknownTypes(iter.Value()); err != nil {
+				return err
+			}
+		}
+	}
+
+	return nil
+}
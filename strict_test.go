@@ -0,0 +1,102 @@
+package slack
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/url"
+	"testing"
+)
+
+const unknownBlockMessage = `{
+	"type": "message",
+	"text": "hello",
+	"blocks": [
+		{"type": "divider"},
+		{"type": "future_block", "block_id": "b1", "elements": []}
+	]
+}`
+
+const unknownAccessoryMessage = `{
+	"type": "message",
+	"blocks": [
+		{"type": "section", "text": {"type": "mrkdwn", "text": "hi"}, "accessory": {"type": "future_picker", "action_id": "t"}}
+	]
+}`
+
+func TestUnmarshalStrict(t *testing.T) {
+	var msg Message
+	if err := UnmarshalStrict([]byte(simpleMessage), &msg); err != nil {
+		t.Fatalf("Unexpected error: %s", err)
+	}
+
+	if err := UnmarshalStrict([]byte(unknownBlockMessage), &msg); err == nil {
+		t.Fatal("Expected an error")
+	} else if e, ok := err.(*UnknownTypeError); !ok || e.Kind != "block" || e.Type != "future_block" || string(e.JSON) != `{"type": "future_block", "block_id": "b1", "elements": []}` {
+		t.Errorf("Unexpected error: %#v", err)
+	}
+
+	if err := UnmarshalStrict([]byte(unknownAccessoryMessage), &msg); err == nil {
+		t.Fatal("Expected an error")
+	} else if e, ok := err.(*UnknownTypeError); !ok || e.Kind != "block element" || e.Type != "future_picker" || string(e.JSON) != `{"type": "future_picker", "action_id": "t"}` {
+		t.Errorf("Unexpected error: %#v", err)
+	}
+}
+
+const unknownRichTextMessage = `{
+	"type": "message",
+	"blocks": [
+		{"type": "rich_text", "elements": [{"type": "rich_text_section", "elements": [{"type": "sparkle"}]}]}
+	]
+}`
+
+func TestUnmarshalStrictRichText(t *testing.T) {
+	var msg Message
+	if err := UnmarshalStrict([]byte(unknownRichTextMessage), &msg); err == nil {
+		t.Fatal("Expected an error")
+	} else if e, ok := err.(*UnknownTypeError); !ok || e.Kind != "rich text element" || e.Type != "sparkle" || string(e.JSON) != `{"type": "sparkle"}` {
+		t.Errorf("Unexpected error: %#v", err)
+	}
+}
+
+func TestUnmarshalUnknownElementTypes(t *testing.T) {
+	for _, tt := range []struct {
+		block string
+		kind  string
+		raw   string
+	}{
+		{`{"type": "context", "elements": [{"type": "sparkle"}]}`, "context element", `{"type": "sparkle"}`},
+		{`{"type": "actions", "elements": [{"type": "future_picker", "action_id": "t"}]}`, "block element", `{"type": "future_picker", "action_id": "t"}`},
+		{`{"type": "input", "label": {"type": "plain_text", "text": "l"}, "element": {"type": "future_picker"}}`, "block element", `{"type": "future_picker"}`},
+	} {
+		var msg Message
+		err := json.Unmarshal([]byte(`{"type": "message", "blocks": [`+tt.block+`]}`), &msg)
+		if e, ok := err.(*UnknownTypeError); !ok || e.Kind != tt.kind || string(e.JSON) != tt.raw {
+			t.Errorf("%s: unexpected error %#v", tt.kind, err)
+		}
+	}
+}
+
+func TestOptionStrictDecoding(t *testing.T) {
+	http.HandleFunc("/strict.test", func(rw http.ResponseWriter, r *http.Request) {
+		rw.Header().Set("Content-Type", "application/json")
+		rw.Write([]byte(`{"ok": true, "message": ` + unknownBlockMessage + `}`))
+	})
+
+	once.Do(startServer)
+	for _, strict := range []bool{false, true} {
+		api := New("testing-token", OptionAPIURL("http://"+serverAddr+"/"), OptionStrictDecoding(strict))
+		response := struct {
+			SlackResponse
+			Message Message `json:"message"`
+		}{}
+
+		err := api.postMethod(context.Background(), "strict.test", url.Values{}, &response)
+		if _, ok := err.(*UnknownTypeError); ok != strict {
+			t.Errorf("strict=%t: unexpected error %v", strict, err)
+		}
+		if len(response.Message.Blocks.BlockSet) != 2 {
+			t.Errorf("strict=%t: expected the blocks to be decoded, got %#v", strict, response.Message.Blocks)
+		}
+	}
+}
@@ -43,6 +43,29 @@ type Login struct {
 	Region    string `json:"region"`
 }
 
+type IntegrationLogResponse struct {
+	Logs   []IntegrationLog `json:"logs"`
+	Paging `json:"paging"`
+	SlackResponse
+}
+
+// IntegrationLog is an entry of the integration audit log, it describes an app
+// or custom integration being added, changed or removed.
+type IntegrationLog struct {
+	UserID      string   `json:"user_id"`
+	UserName    string   `json:"user_name"`
+	Date        JSONTime `json:"date"`
+	ChangeType  string   `json:"change_type"`
+	AppID       string   `json:"app_id,omitempty"`
+	AppType     string   `json:"app_type,omitempty"`
+	ServiceID   string   `json:"service_id,omitempty"`
+	ServiceType string   `json:"service_type,omitempty"`
+	Channel     string   `json:"channel,omitempty"`
+	Scope       string   `json:"scope,omitempty"`
+	Reason      string   `json:"reason,omitempty"`
+	RSSFeed     bool     `json:"rss_feed,omitempty"`
+}
+
 type BillableInfoResponse struct {
 	BillableInfo map[string]BillingActive `json:"billable_info"`
 	SlackResponse
@@ -56,6 +79,8 @@ type BillingActive struct {
 type AccessLogParameters struct {
 	Count int
 	Page  int
+	// Before only returns logins prior to the given time, it allows retrieving logins past the last page.
+	Before JSONTime
 }
 
 // NewAccessLogParameters provides an instance of AccessLogParameters with all the sane default values set
@@ -66,6 +91,24 @@ func NewAccessLogParameters() AccessLogParameters {
 	}
 }
 
+// IntegrationLogParameters contains all the parameters necessary (including the optional ones) for a GetIntegrationLogs() request
+type IntegrationLogParameters struct {
+	AppID      string
+	ChangeType string
+	ServiceID  string
+	User       string
+	Count      int
+	Page       int
+}
+
+// NewIntegrationLogParameters provides an instance of IntegrationLogParameters with all the sane default values set
+func NewIntegrationLogParameters() IntegrationLogParameters {
+	return IntegrationLogParameters{
+		Count: DEFAULT_LOGINS_COUNT,
+		Page:  DEFAULT_LOGINS_PAGE,
+	}
+}
+
 func (api *Client) teamRequest(ctx context.Context, path string, values url.Values) (*TeamResponse, error) {
 	response := &TeamResponse{}
 	err := api.postMethod(ctx, path, values, response)
@@ -129,6 +172,9 @@ func (api *Client) GetAccessLogsContext(ctx context.Context, params AccessLogPar
 	if params.Page != DEFAULT_LOGINS_PAGE {
 		values.Add("page", strconv.Itoa(params.Page))
 	}
+	if params.Before != 0 {
+		values.Add("before", strconv.FormatInt(int64(params.Before), 10))
+	}
 
 	response, err := api.accessLogsRequest(ctx, "team.accessLogs", values)
 	if err != nil {
@@ -137,6 +183,43 @@ func (api *Client) GetAccessLogsContext(ctx context.Context, params AccessLogPar
 	return response.Logins, &response.Paging, nil
 }
 
+// GetIntegrationLogs retrieves a page of integration logs according to the parameters given
+func (api *Client) GetIntegrationLogs(params IntegrationLogParameters) ([]IntegrationLog, *Paging, error) {
+	return api.GetIntegrationLogsContext(context.Background(), params)
+}
+
+// GetIntegrationLogsContext retrieves a page of integration logs according to the parameters given with a custom context
+func (api *Client) GetIntegrationLogsContext(ctx context.Context, params IntegrationLogParameters) ([]IntegrationLog, *Paging, error) {
+	values := url.Values{
+		"token": {api.token},
+	}
+	if params.AppID != "" {
+		values.Add("app_id", params.AppID)
+	}
+	if params.ChangeType != "" {
+		values.Add("change_type", params.ChangeType)
+	}
+	if params.ServiceID != "" {
+		values.Add("service_id", params.ServiceID)
+	}
+	if params.User != "" {
+		values.Add("user", params.User)
+	}
+	if params.Count != DEFAULT_LOGINS_COUNT {
+		values.Add("count", strconv.Itoa(params.Count))
+	}
+	if params.Page != DEFAULT_LOGINS_PAGE {
+		values.Add("page", strconv.Itoa(params.Page))
+	}
+
+	response := &IntegrationLogResponse{}
+	err := api.postMethod(ctx, "team.integrationLogs", values, response)
+	if err != nil {
+		return nil, nil, err
+	}
+	return response.Logs, &response.Paging, response.Err()
+}
+
 // GetBillableInfo ...
 func (api *Client) GetBillableInfo(user string) (map[string]BillingActive, error) {
 	return api.GetBillableInfoContext(context.Background(), user)
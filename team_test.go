@@ -166,3 +166,64 @@ func TestGetAccessLogs(t *testing.T) {
 		t.Fatal(ErrIncorrectResponse)
 	}
 }
+
+func getTeamIntegrationLogs(rw http.ResponseWriter, r *http.Request) {
+	rw.Header().Set("Content-Type", "application/json")
+	response := []byte(`{"ok": true, "logs": [{
+			"service_id": "1234567890",
+			"service_type": "Google Calendar",
+			"user_id": "U1234ABCD",
+			"user_name": "Johnny",
+			"channel": "C1234567890",
+			"date": "1392163200",
+			"change_type": "enabled",
+			"scope": "incoming-webhook"
+		},
+		{
+			"app_id": "2345678901",
+			"app_type": "Johnny App",
+			"user_id": "U2345BCDE",
+			"user_name": "Billy",
+			"date": "1392163201",
+			"change_type": "removed",
+			"reason": "user"
+		}],
+		"paging": {
+			"count": 3,
+			"total": 2,
+			"page": 1,
+			"pages": 1
+		}
+	}`)
+	rw.Write(response)
+}
+
+func TestGetIntegrationLogs(t *testing.T) {
+	http.HandleFunc("/team.integrationLogs", getTeamIntegrationLogs)
+
+	once.Do(startServer)
+	api := New("testing-token", OptionAPIURL("http://"+serverAddr+"/"))
+
+	logs, paging, err := api.GetIntegrationLogs(NewIntegrationLogParameters())
+	if err != nil {
+		t.Errorf("Unexpected error: %s", err)
+		return
+	}
+
+	if len(logs) != 2 {
+		t.Fatal("Should have been 2 logs")
+	}
+
+	if logs[0].ServiceID != "1234567890" || logs[0].ChangeType != "enabled" || logs[0].Scope != "incoming-webhook" {
+		t.Fatal(ErrIncorrectResponse)
+	}
+	if logs[0].Date != 1392163200 {
+		t.Fatal(ErrIncorrectResponse)
+	}
+	if logs[1].AppID != "2345678901" || logs[1].UserName != "Billy" || logs[1].Reason != "user" {
+		t.Fatal(ErrIncorrectResponse)
+	}
+	if paging.Total != 2 {
+		t.Fatal(ErrIncorrectResponse)
+	}
+}
@@ -0,0 +1,104 @@
+package slack
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"time"
+)
+
+// TopicRotationState is the progress of a topic rotation.
+type TopicRotationState struct {
+	// Index is the position of the current topic, it's only meaningful once RotatedAt is set.
+	Index     int       `json:"index"`
+	RotatedAt time.Time `json:"rotated_at"`
+}
+
+// TopicRotationStore persists the state of rotations so a restarted bot continues the rotation.
+type TopicRotationStore interface {
+	// LoadRotation returns the state of the rotation of the channel, the zero value when there is none.
+	LoadRotation(ctx context.Context, channelID string) (TopicRotationState, error)
+	SaveRotation(ctx context.Context, channelID string, state TopicRotationState) error
+}
+
+// TopicRotationConfig describes the topics a channel rotates through.
+type TopicRotationConfig struct {
+	ChannelID string
+	// Topics are set in order, e.g. the names of the people on call each week.
+	Topics []string
+	// Format formats the topic with fmt.Sprintf, e.g. "On call: %s". The topic is used as is when empty.
+	Format string
+	// Store is optional, without it the rotation restarts from the first topic with the process.
+	Store TopicRotationStore
+}
+
+// TopicRotation sets the topic of a channel to the next topic of a list, on a schedule.
+type TopicRotation struct {
+	client *Client
+	config TopicRotationConfig
+
+	mu     sync.Mutex
+	state  TopicRotationState
+	loaded bool
+}
+
+// NewTopicRotation creates a rotation using the provided configuration.
+func NewTopicRotation(client *Client, config TopicRotationConfig) *TopicRotation {
+	return &TopicRotation{
+		client: client,
+		config: config,
+	}
+}
+
+// Rotate sets the topic of the channel to the topic following the current one, starting over
+// after the last one, and returns it. The first rotation sets the first topic.
+func (r *TopicRotation) Rotate(ctx context.Context) (string, error) {
+	if len(r.config.Topics) == 0 {
+		return "", ErrParametersMissing
+	}
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if !r.loaded && r.config.Store != nil {
+		state, err := r.config.Store.LoadRotation(ctx, r.config.ChannelID)
+		if err != nil {
+			return "", err
+		}
+		r.state = state
+	}
+	r.loaded = true
+
+	next := TopicRotationState{RotatedAt: r.client.currentClock().Now()}
+	if !r.state.RotatedAt.IsZero() {
+		next.Index = (r.state.Index + 1) % len(r.config.Topics)
+	}
+
+	topic := r.config.Topics[next.Index]
+	if r.config.Format != "" {
+		topic = fmt.Sprintf(r.config.Format, topic)
+	}
+
+	if _, err := r.client.SetTopicOfConversationContext(ctx, r.config.ChannelID, topic); err != nil {
+		return "", err
+	}
+	r.state = next
+
+	if r.config.Store != nil {
+		if err := r.config.Store.SaveRotation(ctx, r.config.ChannelID, next); err != nil {
+			return topic, err
+		}
+	}
+
+	return topic, nil
+}
+
+// Schedule rotates the topic at every occurrence of the recurrence in loc, e.g. every Monday
+// morning, until ctx is cancelled.
+func (r *TopicRotation) Schedule(ctx context.Context, scheduler *Scheduler, rec Recurrence, loc *time.Location) {
+	scheduler.Schedule(ctx, loc, rec, func(ctx context.Context, _ time.Time) {
+		if _, err := r.Rotate(ctx); err != nil {
+			r.client.Debugf("topic rotation %s: failed to rotate: %v", r.config.ChannelID, err)
+		}
+	})
+}
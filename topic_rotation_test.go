@@ -0,0 +1,91 @@
+package slack
+
+import (
+	"context"
+	"net/http"
+	"sync"
+	"testing"
+	"time"
+)
+
+type memoryRotations struct {
+	mu     sync.Mutex
+	states map[string]TopicRotationState
+}
+
+func (m *memoryRotations) LoadRotation(ctx context.Context, channelID string) (TopicRotationState, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	return m.states[channelID], nil
+}
+
+func (m *memoryRotations) SaveRotation(ctx context.Context, channelID string, state TopicRotationState) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.states[channelID] = state
+	return nil
+}
+
+func TestTopicRotation(t *testing.T) {
+	http.DefaultServeMux = new(http.ServeMux)
+	defer func() { http.DefaultServeMux = new(http.ServeMux) }()
+
+	topics := make(chan string, 10)
+	http.HandleFunc("/conversations.setTopic", func(rw http.ResponseWriter, r *http.Request) {
+		topics <- r.FormValue("channel") + " " + r.FormValue("topic")
+		rw.Header().Set("Content-Type", "application/json")
+		rw.Write([]byte(`{"ok": true, "channel": {"id": "C1"}}`))
+	})
+	once.Do(startServer)
+
+	// Monday the 6th of January 2020.
+	clock := NewManualClock(time.Date(2020, 1, 6, 8, 0, 0, 0, time.UTC))
+	api := New(validToken, OptionAPIURL("http://"+serverAddr+"/"), OptionClock(clock))
+
+	store := &memoryRotations{states: map[string]TopicRotationState{}}
+	config := TopicRotationConfig{
+		ChannelID: "C1",
+		Topics:    []string{"alice", "bob", "carol"},
+		Format:    "On call: %s",
+		Store:     store,
+	}
+
+	rotation := NewTopicRotation(api, config)
+	for _, expected := range []string{"On call: alice", "On call: bob"} {
+		topic, err := rotation.Rotate(context.Background())
+		if err != nil {
+			t.Fatalf("unexpected error: %s", err)
+		}
+		if topic != expected || <-topics != "C1 "+expected {
+			t.Errorf("expected %q, got %q", expected, topic)
+		}
+	}
+	if state := store.states["C1"]; state.Index != 1 || !state.RotatedAt.Equal(clock.Now()) {
+		t.Errorf("unexpected state %#v", state)
+	}
+
+	// a new rotation resumes from the stored state, and wraps around.
+	restarted := NewTopicRotation(api, config)
+	scheduler := NewScheduler(api)
+	ctx, cancel := context.WithCancel(context.Background())
+	restarted.Schedule(ctx, scheduler, Recurrence{Hour: 9, Weekdays: []time.Weekday{time.Monday}}, time.UTC)
+
+	waitForWaiters(t, clock, 1)
+	clock.Advance(time.Hour)
+	if topic := <-topics; topic != "C1 On call: carol" {
+		t.Errorf("unexpected topic %q", topic)
+	}
+
+	waitForWaiters(t, clock, 1)
+	clock.Advance(7 * 24 * time.Hour)
+	if topic := <-topics; topic != "C1 On call: alice" {
+		t.Errorf("unexpected topic %q", topic)
+	}
+
+	cancel()
+	scheduler.Wait()
+
+	if _, err := NewTopicRotation(api, TopicRotationConfig{ChannelID: "C1"}).Rotate(context.Background()); err != ErrParametersMissing {
+		t.Errorf("expected ErrParametersMissing, got %v", err)
+	}
+}
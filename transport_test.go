@@ -0,0 +1,99 @@
+package slack
+
+import (
+	"context"
+	"crypto/tls"
+	"crypto/x509"
+	"encoding/base64"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"testing"
+)
+
+func TestOptionProxy(t *testing.T) {
+	var requested, auth string
+	proxy := httptest.NewServer(http.HandlerFunc(func(rw http.ResponseWriter, r *http.Request) {
+		requested, auth = r.URL.String(), r.Header.Get("Proxy-Authorization")
+		rw.Header().Set("Content-Type", "application/json")
+		rw.Write([]byte(`{"ok": true}`))
+	}))
+	defer proxy.Close()
+
+	proxyURL, err := url.Parse(proxy.URL)
+	if err != nil {
+		t.Fatal(err)
+	}
+	proxyURL.User = url.UserPassword("user", "secret")
+
+	custom := &http.Client{}
+	api := New("testing-token", OptionHTTPClient(custom), OptionAPIURL("http://slack.example.com/api/"), OptionProxy(proxyURL))
+
+	if err := api.postMethod(context.Background(), "auth.test", url.Values{}, &SlackResponse{}); err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	if requested != "http://slack.example.com/api/auth.test" {
+		t.Errorf("unexpected request through the proxy: %s", requested)
+	}
+	if want := "Basic " + base64.StdEncoding.EncodeToString([]byte("user:secret")); auth != want {
+		t.Errorf("expected proxy authorization %q, got %q", want, auth)
+	}
+	if custom.Transport != nil {
+		t.Error("expected the provided http client to be left untouched")
+	}
+
+	if dialer := api.websocketDialer(); dialer.Proxy == nil {
+		t.Error("expected the websocket dialer to use the proxy")
+	}
+}
+
+func TestOptionClientCertificates(t *testing.T) {
+	cert := tls.Certificate{Certificate: [][]byte{[]byte("certificate")}}
+	api := New("testing-token", OptionClientCertificates(cert))
+
+	transport, ok := api.httpclient.(*http.Client).Transport.(*http.Transport)
+	if !ok {
+		t.Fatal("expected the client to use an http.Transport")
+	}
+	if len(transport.TLSClientConfig.Certificates) != 1 {
+		t.Errorf("expected the transport to present the certificate")
+	}
+
+	if dialer := api.websocketDialer(); dialer.TLSClientConfig == nil || len(dialer.TLSClientConfig.Certificates) != 1 {
+		t.Errorf("expected the websocket dialer to present the certificate")
+	}
+}
+
+func TestOptionTLSConfig(t *testing.T) {
+	server := httptest.NewUnstartedServer(http.HandlerFunc(func(rw http.ResponseWriter, r *http.Request) {
+		rw.Header().Set("Content-Type", "application/json")
+		rw.Write([]byte(`{"ok": true}`))
+	}))
+	server.TLS = &tls.Config{MaxVersion: tls.VersionTLS12}
+	server.StartTLS()
+	defer server.Close()
+
+	roots := x509.NewCertPool()
+	roots.AddCert(server.Certificate())
+	config := &tls.Config{
+		RootCAs:      roots,
+		CipherSuites: []uint16{tls.TLS_ECDHE_RSA_WITH_AES_128_GCM_SHA256, tls.TLS_ECDHE_ECDSA_WITH_AES_128_GCM_SHA256},
+	}
+
+	api := New("testing-token", OptionAPIURL(server.URL+"/"), OptionTLSConfig(config), OptionMinTLSVersion(tls.VersionTLS12))
+	if err := api.postMethod(context.Background(), "auth.test", url.Values{}, &SlackResponse{}); err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	if dialer := api.websocketDialer(); dialer.TLSClientConfig == nil || dialer.TLSClientConfig.RootCAs != roots || dialer.TLSClientConfig.MinVersion != tls.VersionTLS12 || len(dialer.TLSClientConfig.CipherSuites) != 2 {
+		t.Errorf("expected the websocket dialer to use the TLS config, got %#v", dialer.TLSClientConfig)
+	}
+	if config.MinVersion != 0 {
+		t.Error("expected the provided config to be left untouched")
+	}
+
+	strict := New("testing-token", OptionAPIURL(server.URL+"/"), OptionTLSConfig(config), OptionMinTLSVersion(tls.VersionTLS13))
+	if err := strict.postMethod(context.Background(), "auth.test", url.Values{}, &SlackResponse{}); err == nil {
+		t.Error("expected the handshake to fail below the minimum version")
+	}
+}
@@ -0,0 +1,85 @@
+package slack
+
+import (
+	"context"
+	"sync"
+	"time"
+)
+
+// UserGroupCache caches the user groups of each user, listing every user group along with its
+// members when the cache is empty or older than the TTL. It's safe for concurrent use.
+type UserGroupCache struct {
+	client *Client
+	ttl    time.Duration
+
+	mu        sync.Mutex
+	byUser    map[string][]UserGroup
+	fetchedAt time.Time
+}
+
+// NewUserGroupCache creates a cache which expires after ttl, a zero ttl disables the caching.
+func NewUserGroupCache(client *Client, ttl time.Duration) *UserGroupCache {
+	return &UserGroupCache{
+		client: client,
+		ttl:    ttl,
+	}
+}
+
+// GetUserGroupsForUser returns the user groups the user is a member of.
+func (c *UserGroupCache) GetUserGroupsForUser(ctx context.Context, userID string) ([]UserGroup, error) {
+	byUser, err := c.groups(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return byUser[userID], nil
+}
+
+// IsMember reports whether the user is a member of the user group, e.g. to authorize an action.
+func (c *UserGroupCache) IsMember(ctx context.Context, userID, userGroupID string) (bool, error) {
+	groups, err := c.GetUserGroupsForUser(ctx, userID)
+	if err != nil {
+		return false, err
+	}
+
+	for _, group := range groups {
+		if group.ID == userGroupID {
+			return true, nil
+		}
+	}
+	return false, nil
+}
+
+// Invalidate drops the cached user groups, e.g. on a subteam_members_changed event.
+func (c *UserGroupCache) Invalidate() {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.byUser = nil
+}
+
+func (c *UserGroupCache) groups(ctx context.Context) (map[string][]UserGroup, error) {
+	clock := c.client.currentClock()
+
+	c.mu.Lock()
+	byUser, fetchedAt := c.byUser, c.fetchedAt
+	c.mu.Unlock()
+	if byUser != nil && clock.Now().Sub(fetchedAt) < c.ttl {
+		return byUser, nil
+	}
+
+	fetchedAt = clock.Now()
+	var groups []UserGroup
+	err := retryRateLimited(ctx, clock, func() (err error) {
+		groups, err = c.client.GetUserGroupsContext(ctx, GetUserGroupsOptionIncludeUsers(true))
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	byUser = userGroupsByUser(groups)
+	c.mu.Lock()
+	c.byUser, c.fetchedAt = byUser, fetchedAt
+	c.mu.Unlock()
+
+	return byUser, nil
+}
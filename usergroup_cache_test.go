@@ -0,0 +1,53 @@
+package slack
+
+import (
+	"context"
+	"net/http"
+	"testing"
+	"time"
+)
+
+func TestUserGroupCache(t *testing.T) {
+	http.DefaultServeMux = new(http.ServeMux)
+	defer func() { http.DefaultServeMux = new(http.ServeMux) }()
+
+	calls := 0
+	groups := `[{"id": "S1", "users": ["U1", "U2"]}, {"id": "S2", "users": ["U2"]}]`
+	http.HandleFunc("/usergroups.list", func(rw http.ResponseWriter, r *http.Request) {
+		calls++
+		if r.FormValue("include_users") != "true" {
+			t.Errorf("Expected the users to be included")
+		}
+		rw.Header().Set("Content-Type", "application/json")
+		rw.Write([]byte(`{"ok": true, "usergroups": ` + groups + `}`))
+	})
+
+	once.Do(startServer)
+	clock := NewManualClock(time.Date(2020, 1, 1, 12, 0, 0, 0, time.UTC))
+	api := New("testing-token", OptionAPIURL("http://"+serverAddr+"/"), OptionClock(clock))
+	cache := NewUserGroupCache(api, time.Hour)
+	ctx := context.Background()
+
+	userGroups, err := cache.GetUserGroupsForUser(ctx, "U2")
+	if err != nil {
+		t.Fatalf("Unexpected error: %s", err)
+	}
+	if len(userGroups) != 2 || userGroups[0].ID != "S1" || userGroups[1].ID != "S2" {
+		t.Errorf("Unexpected user groups %#v", userGroups)
+	}
+
+	groups = `[{"id": "S1", "users": ["U1"]}]`
+	if member, err := cache.IsMember(ctx, "U2", "S1"); err != nil || !member || calls != 1 {
+		t.Errorf("Expected the cached membership, got %t after %d calls, error %v", member, calls, err)
+	}
+
+	clock.Advance(time.Hour)
+	if member, err := cache.IsMember(ctx, "U2", "S1"); err != nil || member || calls != 2 {
+		t.Errorf("Expected the membership to be fetched again, got %t after %d calls, error %v", member, calls, err)
+	}
+
+	cache.Invalidate()
+	if _, err := cache.GetUserGroupsForUser(ctx, "U1"); err != nil || calls != 3 {
+		t.Errorf("Expected the invalidated cache to be fetched again, got %d calls, error %v", calls, err)
+	}
+}
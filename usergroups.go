@@ -237,6 +237,32 @@ func (api *Client) GetUserGroupMembersContext(ctx context.Context, userGroup str
 	return response.Users, nil
 }
 
+// GetUserGroupsForUser returns the user groups the user is a member of, listing the groups along
+// with their members in a single call. A UserGroupCache answers repeated queries without a call.
+func (api *Client) GetUserGroupsForUser(userID string) ([]UserGroup, error) {
+	return api.GetUserGroupsForUserContext(context.Background(), userID)
+}
+
+// GetUserGroupsForUserContext returns the user groups the user is a member of with a custom context
+func (api *Client) GetUserGroupsForUserContext(ctx context.Context, userID string) ([]UserGroup, error) {
+	groups, err := api.GetUserGroupsContext(ctx, GetUserGroupsOptionIncludeUsers(true))
+	if err != nil {
+		return nil, err
+	}
+	return userGroupsByUser(groups)[userID], nil
+}
+
+// userGroupsByUser inverts the members of the groups, returning the groups of each user.
+func userGroupsByUser(groups []UserGroup) map[string][]UserGroup {
+	byUser := map[string][]UserGroup{}
+	for _, group := range groups {
+		for _, user := range group.Users {
+			byUser[user] = append(byUser[user], group)
+		}
+	}
+	return byUser
+}
+
 // UpdateUserGroupMembers will update the members of an existing user group
 func (api *Client) UpdateUserGroupMembers(userGroup string, members string) (UserGroup, error) {
 	return api.UpdateUserGroupMembersContext(context.Background(), userGroup, members)
@@ -184,3 +184,25 @@ func TestGetUserGroups(t *testing.T) {
 		t.Errorf("Got %#v, want %#v", userGroups[0], S0614TZR7)
 	}
 }
+
+func TestGetUserGroupsForUser(t *testing.T) {
+	http.DefaultServeMux = new(http.ServeMux)
+	defer func() { http.DefaultServeMux = new(http.ServeMux) }()
+	http.HandleFunc("/usergroups.list", getUserGroups)
+
+	once.Do(startServer)
+	api := New("testing-token", OptionAPIURL("http://"+serverAddr+"/"))
+
+	userGroups, err := api.GetUserGroupsForUser("user2")
+	if err != nil {
+		t.Fatalf("Unexpected error: %s", err)
+	}
+	if len(userGroups) != 1 || userGroups[0].ID != "S0614TZR7" {
+		t.Errorf("Unexpected user groups %#v", userGroups)
+	}
+
+	userGroups, err = api.GetUserGroupsForUser("user3")
+	if err != nil || len(userGroups) != 0 {
+		t.Errorf("Expected no user groups, got %#v, error %v", userGroups, err)
+	}
+}
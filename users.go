@@ -3,10 +3,10 @@ package slack
 import (
 	"context"
 	"encoding/json"
+	"io"
 	"net/url"
 	"strconv"
 	"strings"
-	"time"
 )
 
 const (
@@ -164,6 +164,7 @@ type EnterpriseUser struct {
 	EnterpriseName string   `json:"enterprise_name"`
 	IsAdmin        bool     `json:"is_admin"`
 	IsOwner        bool     `json:"is_owner"`
+	IsPrimaryOwner bool     `json:"is_primary_owner"`
 	Teams          []string `json:"teams"`
 }
 
@@ -378,7 +379,7 @@ func (api *Client) GetUsersContext(ctx context.Context) (results []User, err err
 			select {
 			case <-ctx.Done():
 				err = ctx.Err()
-			case <-time.After(rateLimitedError.RetryAfter):
+			case <-api.currentClock().After(rateLimitedError.RetryAfter):
 				err = nil
 			}
 		}
@@ -468,6 +469,42 @@ func (api *Client) SetUserPhoto(image string, params UserSetPhotoParams) error {
 // SetUserPhotoContext changes the currently authenticated user's profile image using a custom context
 func (api *Client) SetUserPhotoContext(ctx context.Context, image string, params UserSetPhotoParams) (err error) {
 	response := &SlackResponse{}
+	values, err := api.userPhotoValues(ctx, params)
+	if err != nil {
+		return err
+	}
+
+	err = postLocalWithMultipartResponse(ctx, api.httpclient, api.endpoint+"users.setPhoto", image, "image", values, response, api, MultipartOptions{})
+	if err != nil {
+		return err
+	}
+
+	return response.Err()
+}
+
+// SetUserPhotoFromReader changes the currently authenticated user's profile image, reading the image from r.
+// The name is the filename reported to slack.
+func (api *Client) SetUserPhotoFromReader(name string, r io.Reader, params UserSetPhotoParams) error {
+	return api.SetUserPhotoFromReaderContext(context.Background(), name, r, params)
+}
+
+// SetUserPhotoFromReaderContext changes the currently authenticated user's profile image, reading the image from r, using a custom context
+func (api *Client) SetUserPhotoFromReaderContext(ctx context.Context, name string, r io.Reader, params UserSetPhotoParams) (err error) {
+	response := &SlackResponse{}
+	values, err := api.userPhotoValues(ctx, params)
+	if err != nil {
+		return err
+	}
+
+	err = postWithMultipartResponse(ctx, api.httpclient, api.endpoint+"users.setPhoto", name, "image", values, r, response, api, MultipartOptions{})
+	if err != nil {
+		return err
+	}
+
+	return response.Err()
+}
+
+func (api *Client) userPhotoValues(ctx context.Context, params UserSetPhotoParams) (url.Values, error) {
 	values := url.Values{
 		"token": {api.token},
 	}
@@ -475,18 +512,17 @@ func (api *Client) SetUserPhotoContext(ctx context.Context, image string, params
 		values.Add("crop_x", strconv.Itoa(params.CropX))
 	}
 	if params.CropY != DEFAULT_USER_PHOTO_CROP_Y {
-		values.Add("crop_y", strconv.Itoa(params.CropX))
+		values.Add("crop_y", strconv.Itoa(params.CropY))
 	}
 	if params.CropW != DEFAULT_USER_PHOTO_CROP_W {
 		values.Add("crop_w", strconv.Itoa(params.CropW))
 	}
 
-	err = postLocalWithMultipartResponse(ctx, api.httpclient, api.endpoint+"users.setPhoto", image, "image", values, response, api)
-	if err != nil {
-		return err
+	if err := api.authenticate(ctx, values); err != nil {
+		return nil, err
 	}
 
-	return response.Err()
+	return values, nil
 }
 
 // DeleteUserPhoto deletes the current authenticated user's profile image
@@ -617,3 +653,111 @@ func (api *Client) GetUserProfileContext(ctx context.Context, userID string, inc
 
 	return resp.Profile, nil
 }
+
+// LookupDiscoverableContact reports whether the user with the given email address can be found,
+// and messaged, through Slack Connect.
+func (api *Client) LookupDiscoverableContact(email string) (bool, error) {
+	return api.LookupDiscoverableContactContext(context.Background(), email)
+}
+
+// LookupDiscoverableContactContext reports whether the user with the given email address can be found through Slack Connect with a custom context.
+func (api *Client) LookupDiscoverableContactContext(ctx context.Context, email string) (bool, error) {
+	values := url.Values{
+		"token": {api.token},
+		"email": {email},
+	}
+	response := struct {
+		IsDiscoverable bool `json:"is_discoverable"`
+		SlackResponse
+	}{}
+
+	if err := api.postMethod(ctx, "users.discoverableContacts.lookup", values, &response); err != nil {
+		return false, err
+	}
+
+	return response.IsDiscoverable, response.Err()
+}
+
+// GetSharedConversationsWithUser returns the conversations both the authenticated user, usually
+// the bot, and the given user are members of. For external users these are the shared channels
+// through which the bot can reach them.
+func (api *Client) GetSharedConversationsWithUser(userID string) ([]Channel, error) {
+	return api.GetSharedConversationsWithUserContext(context.Background(), userID)
+}
+
+// GetSharedConversationsWithUserContext returns the conversations both the authenticated user and the given user are members of with a custom context.
+func (api *Client) GetSharedConversationsWithUserContext(ctx context.Context, userID string) ([]Channel, error) {
+	types := []string{"public_channel", "private_channel", "mpim"}
+
+	own, err := api.allConversationsForUser(ctx, "", types)
+	if err != nil {
+		return nil, err
+	}
+
+	member := make(map[string]bool, len(own))
+	for _, channel := range own {
+		member[channel.ID] = true
+	}
+
+	theirs, err := api.allConversationsForUser(ctx, userID, types)
+	if err != nil {
+		return nil, err
+	}
+
+	shared := make([]Channel, 0, len(theirs))
+	for _, channel := range theirs {
+		if member[channel.ID] {
+			shared = append(shared, channel)
+		}
+	}
+
+	return shared, nil
+}
+
+// SharesConversationWithUser reports whether the authenticated user and the given user are members of a common conversation.
+func (api *Client) SharesConversationWithUser(userID string) (bool, error) {
+	return api.SharesConversationWithUserContext(context.Background(), userID)
+}
+
+// SharesConversationWithUserContext reports whether the authenticated user and the given user are members of a common conversation with a custom context.
+func (api *Client) SharesConversationWithUserContext(ctx context.Context, userID string) (bool, error) {
+	shared, err := api.GetSharedConversationsWithUserContext(ctx, userID)
+	if err != nil {
+		return false, err
+	}
+
+	return len(shared) > 0, nil
+}
+
+// allConversationsForUser pages through users.conversations, retrying rate limited requests.
+func (api *Client) allConversationsForUser(ctx context.Context, userID string, types []string) ([]Channel, error) {
+	var (
+		all    []Channel
+		params = GetConversationsForUserParameters{
+			UserID:          userID,
+			Types:           types,
+			Limit:           200,
+			ExcludeArchived: true,
+		}
+	)
+
+	for {
+		var (
+			channels []Channel
+			cursor   string
+		)
+		err := retryRateLimited(ctx, api.currentClock(), func() (err error) {
+			channels, cursor, err = api.GetConversationsForUserContext(ctx, &params)
+			return err
+		})
+		if err != nil {
+			return nil, err
+		}
+
+		all = append(all, channels...)
+		if cursor == "" {
+			return all, nil
+		}
+		params.Cursor = cursor
+	}
+}
@@ -484,6 +484,26 @@ func TestSetUserPhoto(t *testing.T) {
 	}
 }
 
+func TestSetUserPhotoFromReader(t *testing.T) {
+	http.DefaultServeMux = new(http.ServeMux)
+	defer func() { http.DefaultServeMux = new(http.ServeMux) }()
+
+	_, fileContent, teardown := createUserPhoto(t)
+	defer teardown()
+
+	params := UserSetPhotoParams{CropX: 1, CropY: 2, CropW: 30}
+
+	http.HandleFunc("/users.setPhoto", setUserPhotoHandler(fileContent, params))
+
+	once.Do(startServer)
+	api := New(validToken, OptionAPIURL("http://"+serverAddr+"/"))
+
+	err := api.SetUserPhotoFromReader("avatar.png", bytes.NewReader(fileContent), params)
+	if err != nil {
+		t.Fatalf("unexpected error: %+v\n", err)
+	}
+}
+
 func setUserPhotoHandler(wantBytes []byte, wantParams UserSetPhotoParams) http.HandlerFunc {
 	const maxMemory = 1 << 20 // 1 MB
 
@@ -716,3 +736,101 @@ func TestGetUsersReturnsServerError(t *testing.T) {
 		t.Errorf("Expected: %s. Got: %s", expectedErr, err.Error())
 	}
 }
+
+func TestEnterpriseUser(t *testing.T) {
+	var user User
+	err := json.Unmarshal([]byte(`{
+		"id": "W012A3CDE",
+		"team_id": "T012AB3C4",
+		"name": "spengler",
+		"enterprise_user": {
+			"id": "W012A3CDE",
+			"enterprise_id": "E1234ABCD",
+			"enterprise_name": "Umbrella Corp",
+			"is_admin": true,
+			"is_owner": false,
+			"is_primary_owner": false,
+			"teams": ["T012AB3C4", "T056DE7F8"]
+		}
+	}`), &user)
+	if err != nil {
+		t.Fatalf("Unexpected error: %s", err)
+	}
+
+	expected := EnterpriseUser{
+		ID:             "W012A3CDE",
+		EnterpriseID:   "E1234ABCD",
+		EnterpriseName: "Umbrella Corp",
+		IsAdmin:        true,
+		Teams:          []string{"T012AB3C4", "T056DE7F8"},
+	}
+	if !reflect.DeepEqual(expected, user.Enterprise) {
+		t.Errorf("Expected %#v, got %#v", expected, user.Enterprise)
+	}
+}
+
+func TestLookupDiscoverableContact(t *testing.T) {
+	http.DefaultServeMux = new(http.ServeMux)
+	defer func() { http.DefaultServeMux = new(http.ServeMux) }()
+
+	http.HandleFunc("/users.discoverableContacts.lookup", func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		discoverable := r.FormValue("email") == "alice@example.com"
+		fmt.Fprintf(w, `{"ok": true, "is_discoverable": %t}`, discoverable)
+	})
+
+	once.Do(startServer)
+	api := New(validToken, OptionAPIURL("http://"+serverAddr+"/"))
+
+	for email, want := range map[string]bool{"alice@example.com": true, "bob@example.com": false} {
+		got, err := api.LookupDiscoverableContact(email)
+		if err != nil {
+			t.Fatalf("unexpected error: %s", err)
+		}
+		if got != want {
+			t.Errorf("%s: expected %t, got %t", email, want, got)
+		}
+	}
+}
+
+func TestGetSharedConversationsWithUser(t *testing.T) {
+	http.DefaultServeMux = new(http.ServeMux)
+	defer func() { http.DefaultServeMux = new(http.ServeMux) }()
+
+	http.HandleFunc("/users.conversations", func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		switch {
+		case r.FormValue("user") == "" && r.FormValue("cursor") == "":
+			w.Write([]byte(`{"ok": true, "channels": [{"id": "C1"}, {"id": "C2"}], "response_metadata": {"next_cursor": "page2"}}`))
+		case r.FormValue("user") == "":
+			w.Write([]byte(`{"ok": true, "channels": [{"id": "C3"}]}`))
+		case r.FormValue("user") == "W123":
+			w.Write([]byte(`{"ok": true, "channels": [{"id": "C3"}, {"id": "C4"}, {"id": "C1"}]}`))
+		default:
+			w.Write([]byte(`{"ok": true, "channels": []}`))
+		}
+	})
+
+	once.Do(startServer)
+	api := New(validToken, OptionAPIURL("http://"+serverAddr+"/"))
+
+	shared, err := api.GetSharedConversationsWithUser("W123")
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	var ids []string
+	for _, channel := range shared {
+		ids = append(ids, channel.ID)
+	}
+	if !reflect.DeepEqual(ids, []string{"C3", "C1"}) {
+		t.Errorf("unexpected shared conversations: %v", ids)
+	}
+
+	ok, err := api.SharesConversationWithUser("W999")
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	if ok {
+		t.Error("expected no shared conversations")
+	}
+}
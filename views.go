@@ -167,9 +167,8 @@ func (api *Client) OpenViewContext(
 	if err != nil {
 		return nil, err
 	}
-	endpoint := api.endpoint + "views.open"
 	resp := &ViewResponse{}
-	err = postJSON(ctx, api.httpclient, endpoint, api.token, encoded, resp, api)
+	err = api.postJSONMethod(ctx, "views.open", encoded, resp)
 	if err != nil {
 		return nil, err
 	}
@@ -200,9 +199,8 @@ func (api *Client) PublishViewContext(
 	if err != nil {
 		return nil, err
 	}
-	endpoint := api.endpoint + "views.publish"
 	resp := &ViewResponse{}
-	err = postJSON(ctx, api.httpclient, endpoint, api.token, encoded, resp, api)
+	err = api.postJSONMethod(ctx, "views.publish", encoded, resp)
 	if err != nil {
 		return nil, err
 	}
@@ -231,9 +229,8 @@ func (api *Client) PushViewContext(
 	if err != nil {
 		return nil, err
 	}
-	endpoint := api.endpoint + "views.push"
 	resp := &ViewResponse{}
-	err = postJSON(ctx, api.httpclient, endpoint, api.token, encoded, resp, api)
+	err = api.postJSONMethod(ctx, "views.push", encoded, resp)
 	if err != nil {
 		return nil, err
 	}
@@ -265,9 +262,8 @@ func (api *Client) UpdateViewContext(
 	if err != nil {
 		return nil, err
 	}
-	endpoint := api.endpoint + "views.update"
 	resp := &ViewResponse{}
-	err = postJSON(ctx, api.httpclient, endpoint, api.token, encoded, resp, api)
+	err = api.postJSONMethod(ctx, "views.update", encoded, resp)
 	if err != nil {
 		return nil, err
 	}
@@ -1,6 +1,7 @@
 package slack
 
 import (
+	"net/http"
 	"net/url"
 	"sync"
 	"time"
@@ -47,6 +48,20 @@ type RTM struct {
 	// Dialer.
 	dialer *websocket.Dialer
 
+	// dialHeader holds additional headers sent with the websocket handshake.
+	dialHeader http.Header
+	// compression requests permessage-deflate compression of the connection.
+	compression bool
+	// handshakeTimeout overrides the handshake timeout of the dialer when set.
+	handshakeTimeout time.Duration
+
+	// health tracks the latency and reconnects of the connection.
+	health *rtmHealth
+	// healthEvents enables the events reporting dropped pongs.
+	healthEvents bool
+	// resend buffers the outgoing messages which failed to be sent, when enabled.
+	resend *rtmResendBuffer
+
 	// mu is mutex used to prevent RTM connection race conditions
 	mu *sync.Mutex
 
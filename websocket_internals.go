@@ -43,6 +43,13 @@ type LatencyReport struct {
 	Value time.Duration
 }
 
+// PongMissedEvent is used when a ping wasn't answered within the ping interval,
+// it's only sent when enabled with RTMOptionHealthEvents.
+type PongMissedEvent struct {
+	ID     int
+	SentAt time.Time
+}
+
 // InvalidAuthEvent is used in case we can't even authenticate with the API
 type InvalidAuthEvent struct{}
 
@@ -41,6 +41,7 @@ func (rtm *RTM) ManageConnection() {
 			// when the connection is unsuccessful its fatal, and we need to bail out.
 			rtm.Debugf("Failed to connect with RTM on try %d: %s", connectionCount, err)
 			rtm.disconnect()
+			rtm.dropPending()
 			return
 		}
 
@@ -51,6 +52,8 @@ func (rtm *RTM) ManageConnection() {
 		rtm.info = info
 		rtm.mu.Unlock()
 
+		rtm.health.connected(connectionCount, time.Now())
+
 		rtm.IncomingEvents <- RTMEvent{"connected", &ConnectedEvent{
 			ConnectionCount: connectionCount,
 			Info:            info,
@@ -71,6 +74,7 @@ func (rtm *RTM) ManageConnection() {
 			if err = conn.Close(); err != nil {
 				rtm.Debugln("failed to close conn on disconnected RTM", err)
 			}
+			rtm.dropPending()
 			return
 		default:
 			// otherwise continue and run the loop again to reconnect
@@ -182,23 +186,24 @@ func (rtm *RTM) startRTMAndDial(useRTMStart bool) (info *Info, _ *websocket.Conn
 		return nil, nil, err
 	}
 
-	// install connection parameters
+	// install connection parameters, keeping the ones of the url returned by slack.
 	u, err := stdurl.Parse(url)
 	if err != nil {
 		return nil, nil, err
 	}
-	u.RawQuery = rtm.connParams.Encode()
+	if err := rtm.checkHost(u.Hostname()); err != nil {
+		return nil, nil, err
+	}
+	query := u.Query()
+	for key, values := range rtm.connParams {
+		query[key] = values
+	}
+	u.RawQuery = query.Encode()
 	url = u.String()
 
 	rtm.Debugf("Dialing to websocket on url %s", url)
 	// Only use HTTPS for connections to prevent MITM attacks on the connection.
-	upgradeHeader := http.Header{}
-	upgradeHeader.Add("Origin", "https://api.slack.com")
-	dialer := websocket.DefaultDialer
-	if rtm.dialer != nil {
-		dialer = rtm.dialer
-	}
-	conn, _, err := dialer.Dial(url, upgradeHeader)
+	conn, _, err := rtm.rtmDialer().Dial(url, rtm.upgradeHeader())
 	if err != nil {
 		rtm.Debugf("Failed to dial to the websocket: %s", err)
 		return nil, nil, err
@@ -206,6 +211,40 @@ func (rtm *RTM) startRTMAndDial(useRTMStart bool) (info *Info, _ *websocket.Conn
 	return info, conn, err
 }
 
+// rtmDialer returns the dialer for the connection, combining the configured
+// dialer, or the client default, with the dial options of the RTM.
+func (rtm *RTM) rtmDialer() *websocket.Dialer {
+	dialer := rtm.websocketDialer()
+	if rtm.dialer != nil {
+		copied := *rtm.dialer
+		dialer = &copied
+	}
+
+	if rtm.compression {
+		dialer.EnableCompression = true
+	}
+	if rtm.handshakeTimeout > 0 {
+		dialer.HandshakeTimeout = rtm.handshakeTimeout
+	}
+
+	return dialer
+}
+
+// upgradeHeader returns the headers sent with the websocket handshake.
+func (rtm *RTM) upgradeHeader() http.Header {
+	header := http.Header{}
+	for key, values := range rtm.headers {
+		header[key] = append([]string(nil), values...)
+	}
+	for key, values := range rtm.dialHeader {
+		for _, value := range values {
+			header.Add(key, value)
+		}
+	}
+	header.Set("Origin", "https://api.slack.com")
+	return header
+}
+
 // killConnection stops the websocket connection and signals to all goroutines
 // that they should cease listening to the connection for events.
 //
@@ -217,6 +256,7 @@ func (rtm *RTM) killConnection(intentional bool, cause error) (err error) {
 	if rtm.conn != nil {
 		err = rtm.conn.Close()
 	}
+	rtm.health.disconnected()
 
 	rtm.IncomingEvents <- RTMEvent{"disconnected", &DisconnectedEvent{Intentional: intentional, Cause: cause}}
 
@@ -235,6 +275,12 @@ func (rtm *RTM) killConnection(intentional bool, cause error) (err error) {
 func (rtm *RTM) handleEvents(events chan json.RawMessage) {
 	ticker := time.NewTicker(rtm.pingInterval)
 	defer ticker.Stop()
+
+	// a failure leaves the remaining messages buffered, the reader notices the broken connection.
+	if err := rtm.resendPending(); err != nil {
+		rtm.Debugln("failed to resend the buffered messages:", err)
+	}
+
 	for {
 		select {
 		// catch "stop" signal on channel close
@@ -308,7 +354,8 @@ func (rtm *RTM) sendWithDeadline(msg interface{}) error {
 // sendOutgoingMessage sends the given OutgoingMessage to the slack websocket.
 //
 // It does not currently detect if a outgoing message fails due to a disconnect
-// and instead lets a future failed 'PING' detect the failed connection.
+// and instead lets a future failed 'PING' detect the failed connection. With a
+// resend buffer the failed messages are sent again once reconnected.
 func (rtm *RTM) sendOutgoingMessage(msg OutgoingMessage) {
 	rtm.Debugln("Sending message:", msg)
 	if len([]rune(msg.Text)) > MaxMessageTextLength {
@@ -320,6 +367,15 @@ func (rtm *RTM) sendOutgoingMessage(msg OutgoingMessage) {
 	}
 
 	if err := rtm.sendWithDeadline(msg); err != nil {
+		if rtm.resend != nil {
+			if !rtm.resend.add(msg) {
+				rtm.IncomingEvents <- RTMEvent{"outgoing_dropped", &DroppedMessageEvent{
+					Message:  msg,
+					ErrorObj: err,
+				}}
+			}
+			return
+		}
 		rtm.IncomingEvents <- RTMEvent{"outgoing_error", &OutgoingErrorEvent{
 			Message:  msg,
 			ErrorObj: err,
@@ -337,12 +393,21 @@ func (rtm *RTM) sendOutgoingMessage(msg OutgoingMessage) {
 func (rtm *RTM) ping() error {
 	id := rtm.idGen.Next()
 	rtm.Debugln("Sending PING ", id)
-	msg := &Ping{ID: id, Type: "ping", Timestamp: time.Now().Unix()}
+	now := time.Now()
+	msg := &Ping{ID: id, Type: "ping", Timestamp: now.Unix()}
 
 	if err := rtm.sendWithDeadline(msg); err != nil {
 		rtm.Debugf("RTM Error sending 'PING %d': %s", id, err.Error())
 		return err
 	}
+
+	for _, missed := range rtm.health.pingSent(id, now, rtm.pingInterval) {
+		rtm.Debugf("RTM 'PING %d' was not answered", missed.ID)
+		if rtm.healthEvents {
+			missed := missed
+			rtm.IncomingEvents <- RTMEvent{"pong_missed", &missed}
+		}
+	}
 	return nil
 }
 
@@ -457,7 +522,10 @@ func (rtm *RTM) handlePong(event json.RawMessage) {
 		return
 	}
 
-	latency := time.Since(time.Unix(p.Timestamp, 0))
+	latency, ok := rtm.health.pongReceived(p.ReplyTo, time.Now())
+	if !ok {
+		latency = time.Since(time.Unix(p.Timestamp, 0))
+	}
 	rtm.IncomingEvents <- RTMEvent{"latency_report", &LatencyReport{Value: latency}}
 }
 
@@ -4,6 +4,7 @@ import (
 	"fmt"
 	"log"
 	"net/http"
+	"net/url"
 	"testing"
 	"time"
 
@@ -312,3 +313,41 @@ func TestRTMSingleConnect(t *testing.T) {
 	assert.True(t, connectedReceived, "Should have received a connected event from the RTM instance.")
 	assert.True(t, testMessageReceived, "Should have received a test message from the server.")
 }
+
+func TestRTMConnectURLQuery(t *testing.T) {
+	queries := make(chan url.Values, 10)
+	testServer := slacktest.NewTestServer(
+		func(c slacktest.Customize) {
+			c.Handle("/rtm.connect", func(w http.ResponseWriter, r *http.Request) {
+				wsurl := r.Context().Value(slacktest.ServerWSContextKey).(string)
+				w.Header().Set("Content-Type", "application/json")
+				fmt.Fprintf(w, `{"ok": true, "url": "%s?ticket=abc", "self": {"id": "U1"}, "team": {"id": "T1"}}`, wsurl)
+			})
+			c.Handle("/ws", func(w http.ResponseWriter, r *http.Request) {
+				queries <- r.URL.Query()
+				slacktest.Websocket(func(conn *websocket.Conn) {
+					for {
+						if _, _, err := conn.ReadMessage(); err != nil {
+							return
+						}
+					}
+				})(w, r)
+			})
+		},
+	)
+	go testServer.Start()
+	defer testServer.Stop()
+
+	api := slack.New(testToken, slack.OptionAPIURL(testServer.GetAPIURL()))
+	rtm := api.NewRTM(slack.RTMOptionConnParams(url.Values{"batch_presence_aware": {"1"}}))
+	go rtm.ManageConnection()
+	defer rtm.Disconnect()
+
+	select {
+	case query := <-queries:
+		assert.Equal(t, "abc", query.Get("ticket"))
+		assert.Equal(t, "1", query.Get("batch_presence_aware"))
+	case <-time.After(5 * time.Second):
+		t.Fatal("timed out waiting for the websocket connection")
+	}
+}
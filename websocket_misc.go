@@ -32,6 +32,11 @@ func (s RTMError) Error() string {
 // MessageEvent represents a Slack Message (used as the event type for an incoming message)
 type MessageEvent Message
 
+// MarshalJSON marshals the event like a Message.
+func (t MessageEvent) MarshalJSON() ([]byte, error) {
+	return Message(t).MarshalJSON()
+}
+
 // RTMEvent is the main wrapper. You will find all the other messages attached
 type RTMEvent struct {
 	Type string